@@ -0,0 +1,43 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/tigusigalpa/yandex-cloud-client-go/errors"
+)
+
+// Credentials provides IAM tokens used to authenticate API requests.
+// Implementations must be safe for concurrent use.
+type Credentials interface {
+	// GetValidIAMToken returns a valid IAM token, refreshing it when needed
+	GetValidIAMToken(ctx context.Context) (string, error)
+}
+
+// CredentialsFunc adapts a function to the Credentials interface
+type CredentialsFunc func(ctx context.Context) (string, error)
+
+// GetValidIAMToken calls f(ctx)
+func (f CredentialsFunc) GetValidIAMToken(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+// StaticCredentials provides a fixed IAM token (e.g. obtained with `yc iam create-token`)
+type StaticCredentials struct {
+	iamToken string
+}
+
+// NewStaticCredentials creates new static credentials
+func NewStaticCredentials(iamToken string) (*StaticCredentials, error) {
+	if iamToken == "" {
+		return nil, errors.NewAuthenticationError("IAM token cannot be empty", nil)
+	}
+
+	return &StaticCredentials{
+		iamToken: iamToken,
+	}, nil
+}
+
+// GetValidIAMToken returns the static IAM token
+func (c *StaticCredentials) GetValidIAMToken(ctx context.Context) (string, error) {
+	return c.iamToken, nil
+}
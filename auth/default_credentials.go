@@ -0,0 +1,144 @@
+package auth
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/tigusigalpa/yandex-cloud-client-go/errors"
+)
+
+// Environment variables used by DefaultCredentials
+const (
+	// EnvToken holds an OAuth token (or an IAM token, if it starts with "t1.")
+	EnvToken = "YC_TOKEN"
+	// EnvIAMToken holds an IAM token
+	EnvIAMToken = "YC_IAM_TOKEN"
+	// EnvServiceAccountKeyFile holds a path to a service account authorized key file
+	EnvServiceAccountKeyFile = "YC_SERVICE_ACCOUNT_KEY_FILE"
+	// EnvProfile overrides the active yc CLI profile
+	EnvProfile = "YC_PROFILE"
+)
+
+// iamTokenPrefix is the prefix of Yandex Cloud IAM tokens
+const iamTokenPrefix = "t1."
+
+// DefaultCredentials resolves credentials in the following order:
+//
+//  1. YC_TOKEN (OAuth token, or IAM token if it starts with "t1.") or YC_IAM_TOKEN
+//  2. YC_SERVICE_ACCOUNT_KEY_FILE
+//  3. the active profile in the yc CLI config (~/.config/yandex-cloud/config.yaml)
+//  4. the metadata service
+//
+// The metadata service is not probed: if it is unavailable, the error is returned on first use.
+func DefaultCredentials(httpClient *http.Client) (Credentials, error) {
+	if token := os.Getenv(EnvToken); token != "" {
+		if strings.HasPrefix(token, iamTokenPrefix) {
+			return NewStaticCredentials(token)
+		}
+		return NewIAMTokenManager(token, httpClient)
+	}
+
+	if token := os.Getenv(EnvIAMToken); token != "" {
+		return NewStaticCredentials(token)
+	}
+
+	if path := os.Getenv(EnvServiceAccountKeyFile); path != "" {
+		key, err := LoadServiceAccountKeyFile(path)
+		if err != nil {
+			return nil, err
+		}
+		credentials, err := NewServiceAccountKeyCredentials(key, httpClient)
+		if err != nil {
+			return nil, err
+		}
+		return credentials, nil
+	}
+
+	credentials, err := ProfileCredentials(httpClient)
+	if err != nil {
+		return nil, err
+	}
+	if credentials != nil {
+		return credentials, nil
+	}
+
+	return NewMetadataCredentials(httpClient), nil
+}
+
+// ycConfig is the subset of the yc CLI config used to resolve credentials
+type ycConfig struct {
+	Current  string                     `yaml:"current"`
+	Profiles map[string]ycConfigProfile `yaml:"profiles"`
+}
+
+// ycConfigProfile is a yc CLI profile
+type ycConfigProfile struct {
+	Token                  string             `yaml:"token"`
+	ServiceAccountKey      *ServiceAccountKey `yaml:"service-account-key"`
+	InstanceServiceAccount bool               `yaml:"instance-service-account"`
+}
+
+// YCConfigPath returns the path of the yc CLI config
+func YCConfigPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".config", "yandex-cloud", "config.yaml"), nil
+}
+
+// ProfileCredentials returns credentials of the active yc CLI profile (or the one set in YC_PROFILE).
+// It returns nil credentials without error if there is no config or the profile has no supported credentials.
+func ProfileCredentials(httpClient *http.Client) (Credentials, error) {
+	path, err := YCConfigPath()
+	if err != nil {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.NewAuthenticationError("Failed to read yc config", err)
+	}
+
+	var config ycConfig
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, errors.NewAuthenticationError("Failed to parse yc config", err)
+	}
+
+	profileName := os.Getenv(EnvProfile)
+	if profileName == "" {
+		profileName = config.Current
+	}
+
+	profile, ok := config.Profiles[profileName]
+	if !ok {
+		return nil, nil
+	}
+
+	switch {
+	case profile.Token != "":
+		if strings.HasPrefix(profile.Token, iamTokenPrefix) {
+			return NewStaticCredentials(profile.Token)
+		}
+		return NewIAMTokenManager(profile.Token, httpClient)
+
+	case profile.ServiceAccountKey != nil:
+		credentials, err := NewServiceAccountKeyCredentials(profile.ServiceAccountKey, httpClient)
+		if err != nil {
+			return nil, err
+		}
+		return credentials, nil
+
+	case profile.InstanceServiceAccount:
+		return NewMetadataCredentials(httpClient), nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,307 @@
+package auth
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/tigusigalpa/yandex-cloud-client-go/errors"
+)
+
+// isolateEnv clears the credential environment variables and points the home directory
+// at an empty temporary directory, so no real yc config is picked up
+func isolateEnv(t *testing.T) string {
+	t.Helper()
+
+	for _, name := range []string{EnvToken, EnvIAMToken, EnvServiceAccountKeyFile, EnvProfile} {
+		t.Setenv(name, "")
+	}
+
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+	return home
+}
+
+// writeYCConfig writes config as the yc CLI config in home
+func writeYCConfig(t *testing.T, home string, config ycConfig) {
+	t.Helper()
+
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path, err := YCConfigPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Never overwrite a real yc config
+	if filepath.Dir(filepath.Dir(filepath.Dir(path))) != home {
+		t.Fatalf("yc config path %s is outside %s", path, home)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// testKey returns a parsed service account key
+func testKey(t *testing.T) *ServiceAccountKey {
+	t.Helper()
+
+	data, _ := testServiceAccountKey(t)
+	key, err := ParseServiceAccountKey(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+// assertStaticToken fails unless credentials are static credentials with token
+func assertStaticToken(t *testing.T, credentials Credentials, token string) {
+	t.Helper()
+
+	static, ok := credentials.(*StaticCredentials)
+	if !ok {
+		t.Fatalf("credentials = %T, want *StaticCredentials", credentials)
+	}
+	if static.iamToken != token {
+		t.Errorf("IAM token = %q, want %q", static.iamToken, token)
+	}
+}
+
+// assertOAuthToken fails unless credentials are an IAM token manager for token
+func assertOAuthToken(t *testing.T, credentials Credentials, token string) {
+	t.Helper()
+
+	manager, ok := credentials.(*IAMTokenManager)
+	if !ok {
+		t.Fatalf("credentials = %T, want *IAMTokenManager", credentials)
+	}
+	if manager.oauthToken != token {
+		t.Errorf("OAuth token = %q, want %q", manager.oauthToken, token)
+	}
+}
+
+func TestDefaultCredentialsFromEnv(t *testing.T) {
+	t.Run("YC_TOKEN OAuth", func(t *testing.T) {
+		isolateEnv(t)
+		t.Setenv(EnvToken, "y0_oauth")
+
+		credentials, err := DefaultCredentials(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertOAuthToken(t, credentials, "y0_oauth")
+	})
+
+	t.Run("YC_TOKEN IAM", func(t *testing.T) {
+		isolateEnv(t)
+		t.Setenv(EnvToken, "t1.iam")
+		t.Setenv(EnvIAMToken, "t1.ignored")
+
+		credentials, err := DefaultCredentials(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertStaticToken(t, credentials, "t1.iam")
+	})
+
+	t.Run("YC_IAM_TOKEN", func(t *testing.T) {
+		isolateEnv(t)
+		t.Setenv(EnvIAMToken, "t1.iam")
+
+		credentials, err := DefaultCredentials(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertStaticToken(t, credentials, "t1.iam")
+	})
+
+	t.Run("YC_SERVICE_ACCOUNT_KEY_FILE", func(t *testing.T) {
+		home := isolateEnv(t)
+		data, _ := testServiceAccountKey(t)
+		path := filepath.Join(home, "key.json")
+		if err := os.WriteFile(path, data, 0o600); err != nil {
+			t.Fatal(err)
+		}
+		t.Setenv(EnvServiceAccountKeyFile, path)
+
+		credentials, err := DefaultCredentials(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		key, ok := credentials.(*ServiceAccountKeyCredentials)
+		if !ok {
+			t.Fatalf("credentials = %T, want *ServiceAccountKeyCredentials", credentials)
+		}
+		if key.keyID != "ajekey" || key.serviceAccountID != "ajesa" {
+			t.Errorf("key = %s of %s, want ajekey of ajesa", key.keyID, key.serviceAccountID)
+		}
+	})
+
+	t.Run("missing key file", func(t *testing.T) {
+		home := isolateEnv(t)
+		t.Setenv(EnvServiceAccountKeyFile, filepath.Join(home, "missing.json"))
+
+		var authErr *errors.AuthenticationError
+		if _, err := DefaultCredentials(nil); !stderrors.As(err, &authErr) {
+			t.Errorf("error = %v, want an AuthenticationError", err)
+		}
+	})
+}
+
+func TestProfileCredentials(t *testing.T) {
+	key := testKey(t)
+
+	config := ycConfig{
+		Current: "oauth",
+		Profiles: map[string]ycConfigProfile{
+			"oauth":    {Token: "y0_profile"},
+			"iam":      {Token: "t1.profile"},
+			"key":      {ServiceAccountKey: key},
+			"instance": {InstanceServiceAccount: true},
+			"empty":    {},
+		},
+	}
+
+	t.Run("current profile", func(t *testing.T) {
+		writeYCConfig(t, isolateEnv(t), config)
+
+		credentials, err := ProfileCredentials(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertOAuthToken(t, credentials, "y0_profile")
+	})
+
+	t.Run("YC_PROFILE IAM token", func(t *testing.T) {
+		writeYCConfig(t, isolateEnv(t), config)
+		t.Setenv(EnvProfile, "iam")
+
+		credentials, err := ProfileCredentials(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertStaticToken(t, credentials, "t1.profile")
+	})
+
+	t.Run("YC_PROFILE service account key", func(t *testing.T) {
+		writeYCConfig(t, isolateEnv(t), config)
+		t.Setenv(EnvProfile, "key")
+
+		credentials, err := ProfileCredentials(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sa, ok := credentials.(*ServiceAccountKeyCredentials)
+		if !ok {
+			t.Fatalf("credentials = %T, want *ServiceAccountKeyCredentials", credentials)
+		}
+		if sa.keyID != key.ID || sa.serviceAccountID != key.ServiceAccountID {
+			t.Errorf("key = %s of %s, want %s of %s", sa.keyID, sa.serviceAccountID, key.ID, key.ServiceAccountID)
+		}
+	})
+
+	t.Run("YC_PROFILE instance service account", func(t *testing.T) {
+		writeYCConfig(t, isolateEnv(t), config)
+		t.Setenv(EnvProfile, "instance")
+
+		credentials, err := ProfileCredentials(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := credentials.(*MetadataCredentials); !ok {
+			t.Fatalf("credentials = %T, want *MetadataCredentials", credentials)
+		}
+	})
+
+	for _, profile := range []string{"empty", "unknown"} {
+		t.Run("YC_PROFILE "+profile, func(t *testing.T) {
+			writeYCConfig(t, isolateEnv(t), config)
+			t.Setenv(EnvProfile, profile)
+
+			credentials, err := ProfileCredentials(nil)
+			if credentials != nil || err != nil {
+				t.Errorf("ProfileCredentials = %T, %v; want nil, nil", credentials, err)
+			}
+		})
+	}
+
+	t.Run("no config", func(t *testing.T) {
+		isolateEnv(t)
+
+		credentials, err := ProfileCredentials(nil)
+		if credentials != nil || err != nil {
+			t.Errorf("ProfileCredentials = %T, %v; want nil, nil", credentials, err)
+		}
+	})
+
+	t.Run("malformed config", func(t *testing.T) {
+		isolateEnv(t)
+		path, err := YCConfigPath()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte("profiles: ["), 0o600); err != nil {
+			t.Fatal(err)
+		}
+
+		var authErr *errors.AuthenticationError
+		if _, err := ProfileCredentials(nil); !stderrors.As(err, &authErr) {
+			t.Errorf("error = %v, want an AuthenticationError", err)
+		}
+	})
+}
+
+func TestDefaultCredentialsPrefersEnvOverProfile(t *testing.T) {
+	writeYCConfig(t, isolateEnv(t), ycConfig{
+		Current:  "default",
+		Profiles: map[string]ycConfigProfile{"default": {Token: "y0_profile"}},
+	})
+	t.Setenv(EnvIAMToken, "t1.env")
+
+	credentials, err := DefaultCredentials(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertStaticToken(t, credentials, "t1.env")
+}
+
+func TestDefaultCredentialsFallsBackToMetadata(t *testing.T) {
+	writeYCConfig(t, isolateEnv(t), ycConfig{
+		Current:  "empty",
+		Profiles: map[string]ycConfigProfile{"empty": {}},
+	})
+
+	credentials, err := DefaultCredentials(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	metadata, ok := credentials.(*MetadataCredentials)
+	if !ok {
+		t.Fatalf("credentials = %T, want *MetadataCredentials", credentials)
+	}
+
+	// The metadata service is not probed: without one, the error is returned on first use
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+	metadata.SetAddress(server.URL)
+
+	var authErr *errors.AuthenticationError
+	if _, err := metadata.GetValidIAMToken(context.Background()); !stderrors.As(err, &authErr) {
+		t.Errorf("error = %v, want an AuthenticationError", err)
+	}
+}
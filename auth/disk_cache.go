@@ -0,0 +1,230 @@
+package auth
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/tigusigalpa/yandex-cloud-client-go/errors"
+)
+
+const (
+	diskCacheKeyFile  = "key"
+	diskCacheKeySize  = 32
+	lockRetryInterval = 50 * time.Millisecond
+)
+
+// DiskTokenCache stores IAM tokens on disk so that multiple processes reuse a valid token
+// until its expiry. Tokens are keyed by a fingerprint of the credentials, encrypted with
+// AES-GCM using a local key generated in the cache directory, and refreshed under a file lock,
+// so only one process exchanges a token at a time.
+//
+// The key is stored in the same directory as the encrypted tokens, so encryption only helps
+// against someone who can read cache files but not the directory (e.g. a copied token file).
+// The directory permissions (0700) are what keeps other users out.
+type DiskTokenCache struct {
+	dir  string
+	aead cipher.AEAD
+}
+
+// diskCacheEntry is the encrypted payload of a cache file
+type diskCacheEntry struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// DefaultTokenCacheDir returns the default token cache directory
+func DefaultTokenCacheDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "yandex-cloud-client-go", "tokens"), nil
+}
+
+// NewDiskTokenCache creates a token cache in dir (DefaultTokenCacheDir if empty).
+// The directory is created or restricted to the current user (0700).
+func NewDiskTokenCache(dir string) (*DiskTokenCache, error) {
+	if dir == "" {
+		defaultDir, err := DefaultTokenCacheDir()
+		if err != nil {
+			return nil, errors.NewAuthenticationError("Failed to resolve token cache directory", err)
+		}
+		dir = defaultDir
+	}
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, errors.NewAuthenticationError("Failed to create token cache directory", err)
+	}
+
+	// MkdirAll keeps the permissions of an existing directory
+	if err := os.Chmod(dir, 0o700); err != nil {
+		return nil, errors.NewAuthenticationError("Failed to restrict token cache directory permissions", err)
+	}
+
+	key, err := loadOrCreateCacheKey(filepath.Join(dir, diskCacheKeyFile))
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.NewAuthenticationError("Failed to initialize token cache encryption", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.NewAuthenticationError("Failed to initialize token cache encryption", err)
+	}
+
+	return &DiskTokenCache{
+		dir:  dir,
+		aead: aead,
+	}, nil
+}
+
+// loadOrCreateCacheKey reads the local encryption key, generating it on first use
+func loadOrCreateCacheKey(path string) ([]byte, error) {
+	key := make([]byte, diskCacheKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, errors.NewAuthenticationError("Failed to generate token cache key", err)
+	}
+
+	// O_EXCL makes concurrent first runs agree on a single key
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err == nil {
+		_, err = file.Write(key)
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			os.Remove(path)
+			return nil, errors.NewAuthenticationError("Failed to write token cache key", err)
+		}
+		return key, nil
+	}
+
+	if !os.IsExist(err) {
+		return nil, errors.NewAuthenticationError("Failed to create token cache key", err)
+	}
+
+	// Another process may still be writing the key
+	for attempt := 0; attempt < 20; attempt++ {
+		key, err = os.ReadFile(path)
+		if err != nil {
+			return nil, errors.NewAuthenticationError("Failed to read token cache key", err)
+		}
+		if len(key) == diskCacheKeySize {
+			return key, nil
+		}
+		time.Sleep(lockRetryInterval)
+	}
+
+	return nil, errors.NewAuthenticationError("Token cache key is corrupted", nil)
+}
+
+// fetch returns the cached token for fingerprint if it is not due for refresh,
+// otherwise it calls fetch under the file lock and stores the result. If invalidate is set,
+// the cached token is removed and never returned, e.g. after it was rejected and cleared.
+// The cache is best-effort: lock, read and write failures fall back to fetch.
+func (c *DiskTokenCache) fetch(ctx context.Context, fingerprint string, fetch tokenFetcher, invalidate bool) (string, time.Time, error) {
+	name := fingerprintFileName(fingerprint)
+
+	unlock, err := lockFile(ctx, filepath.Join(c.dir, name+".lock"))
+	if err != nil {
+		if ctx.Err() != nil {
+			return "", time.Time{}, ctx.Err()
+		}
+		return fetch(ctx)
+	}
+	defer unlock()
+
+	path := filepath.Join(c.dir, name+".token")
+	if invalidate {
+		os.Remove(path)
+	} else if entry, ok := c.load(path, fingerprint); ok && time.Until(entry.ExpiresAt) > tokenRefreshMargin {
+		return entry.Token, entry.ExpiresAt, nil
+	}
+
+	token, expiresAt, err := fetch(ctx)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	if expiresAt.IsZero() {
+		expiresAt = time.Now().Add(tokenLifetime)
+	}
+
+	c.save(path, fingerprint, diskCacheEntry{Token: token, ExpiresAt: expiresAt})
+
+	return token, expiresAt, nil
+}
+
+// load reads and decrypts a cache file
+func (c *DiskTokenCache) load(path, fingerprint string) (diskCacheEntry, bool) {
+	var entry diskCacheEntry
+
+	data, err := os.ReadFile(path)
+	if err != nil || len(data) < c.aead.NonceSize() {
+		return entry, false
+	}
+
+	nonce, ciphertext := data[:c.aead.NonceSize()], data[c.aead.NonceSize():]
+	plaintext, err := c.aead.Open(nil, nonce, ciphertext, []byte(fingerprint))
+	if err != nil {
+		return entry, false
+	}
+
+	if err := json.Unmarshal(plaintext, &entry); err != nil || entry.Token == "" {
+		return entry, false
+	}
+
+	return entry, true
+}
+
+// save encrypts and atomically writes a cache file
+func (c *DiskTokenCache) save(path, fingerprint string, entry diskCacheEntry) {
+	plaintext, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return
+	}
+
+	data := c.aead.Seal(nonce, nonce, plaintext, []byte(fingerprint))
+
+	tmp, err := os.CreateTemp(c.dir, ".token-*")
+	if err != nil {
+		return
+	}
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil || os.Rename(tmp.Name(), path) != nil {
+		os.Remove(tmp.Name())
+	}
+}
+
+// fingerprintFileName returns the cache file name for a credential fingerprint
+func fingerprintFileName(fingerprint string) string {
+	sum := sha256.Sum256([]byte(fingerprint))
+	return hex.EncodeToString(sum[:])
+}
+
+// credentialFingerprint joins the parts identifying credentials into a fingerprint
+func credentialFingerprint(parts ...string) string {
+	return strings.Join(parts, "\x00")
+}
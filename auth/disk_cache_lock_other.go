@@ -0,0 +1,37 @@
+//go:build !unix
+
+package auth
+
+import (
+	"context"
+	"os"
+	"time"
+)
+
+// staleLockAge is the age after which a lock file left by a crashed process is removed
+const staleLockAge = 2 * refreshTimeout
+
+// lockFile acquires an exclusive lock by creating path, waiting until ctx is done
+func lockFile(ctx context.Context, path string) (func(), error) {
+	for {
+		file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+		if err == nil {
+			file.Close()
+			return func() {
+				os.Remove(path)
+			}, nil
+		}
+		if !os.IsExist(err) {
+			return nil, err
+		}
+
+		if info, err := os.Stat(path); err == nil && time.Since(info.ModTime()) > staleLockAge {
+			os.Remove(path)
+			continue
+		}
+
+		if err := sleep(ctx, lockRetryInterval); err != nil {
+			return nil, err
+		}
+	}
+}
@@ -0,0 +1,37 @@
+//go:build unix
+
+package auth
+
+import (
+	"context"
+	"os"
+	"syscall"
+)
+
+// lockFile acquires an exclusive advisory lock on path, waiting until ctx is done
+func lockFile(ctx context.Context, path string) (func(), error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		err := syscall.Flock(int(file.Fd()), syscall.LOCK_EX|syscall.LOCK_NB)
+		if err == nil {
+			break
+		}
+		if err != syscall.EWOULDBLOCK && err != syscall.EINTR {
+			file.Close()
+			return nil, err
+		}
+		if err := sleep(ctx, lockRetryInterval); err != nil {
+			file.Close()
+			return nil, err
+		}
+	}
+
+	return func() {
+		syscall.Flock(int(file.Fd()), syscall.LOCK_UN)
+		file.Close()
+	}, nil
+}
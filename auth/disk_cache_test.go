@@ -0,0 +1,223 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// countingFetcher returns a fetcher issuing token-1, token-2, ... valid for an hour
+func countingFetcher(fetches *atomic.Int32) tokenFetcher {
+	return func(ctx context.Context) (string, time.Time, error) {
+		n := fetches.Add(1)
+		return fmt.Sprintf("token-%d", n), time.Now().Add(time.Hour), nil
+	}
+}
+
+// newDiskBackedCache creates a token cache for fingerprint stored in disk
+func newDiskBackedCache(disk *DiskTokenCache, fingerprint string, fetch tokenFetcher) *tokenCache {
+	cache := newTokenCache(fetch)
+	cache.fingerprint = func() string { return fingerprint }
+	cache.disk = disk
+	return cache
+}
+
+func TestDiskTokenCacheSharesTokenAcrossInstances(t *testing.T) {
+	dir := t.TempDir()
+	var fetches atomic.Int32
+
+	for i := 0; i < 3; i++ {
+		// A new DiskTokenCache per instance, as in separate processes
+		disk, err := NewDiskTokenCache(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		cache := newDiskBackedCache(disk, "oauth\x00secret", countingFetcher(&fetches))
+		if token, err := cache.get(context.Background()); err != nil || token != "token-1" {
+			t.Fatalf("instance %d: get = %q, %v; want token-1", i, token, err)
+		}
+	}
+
+	if n := fetches.Load(); n != 1 {
+		t.Fatalf("fetched %d times, want 1", n)
+	}
+}
+
+func TestDiskTokenCacheSeparatesFingerprints(t *testing.T) {
+	disk, err := NewDiskTokenCache(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var fetches atomic.Int32
+	first := newDiskBackedCache(disk, "oauth\x00first", countingFetcher(&fetches))
+	second := newDiskBackedCache(disk, "oauth\x00second", countingFetcher(&fetches))
+
+	if token, _ := first.get(context.Background()); token != "token-1" {
+		t.Fatalf("first token = %q, want token-1", token)
+	}
+	if token, _ := second.get(context.Background()); token != "token-2" {
+		t.Fatalf("second token = %q, want token-2", token)
+	}
+}
+
+func TestDiskTokenCacheEncryptsFiles(t *testing.T) {
+	dir := t.TempDir()
+	disk, err := NewDiskTokenCache(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cache := newDiskBackedCache(disk, "oauth\x00secret", func(ctx context.Context) (string, time.Time, error) {
+		return "t1.super-secret-iam-token", time.Now().Add(time.Hour), nil
+	})
+	if _, err := cache.get(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	tokenFile := filepath.Join(dir, fingerprintFileName("oauth\x00secret")+".token")
+	data, err := os.ReadFile(tokenFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(data, []byte("super-secret")) {
+		t.Fatal("token is stored in plaintext")
+	}
+
+	if runtime.GOOS != "windows" {
+		for _, path := range []string{tokenFile, filepath.Join(dir, diskCacheKeyFile)} {
+			info, err := os.Stat(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if perm := info.Mode().Perm(); perm != 0o600 {
+				t.Errorf("%s permissions = %o, want 600", filepath.Base(path), perm)
+			}
+		}
+	}
+
+	// Another fingerprint cannot decrypt the entry
+	if _, ok := disk.load(tokenFile, "oauth\x00other"); ok {
+		t.Fatal("entry decrypted with a different fingerprint")
+	}
+}
+
+func TestDiskTokenCacheRestrictsDirectory(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Unix permissions")
+	}
+
+	existing := t.TempDir()
+	if err := os.Chmod(existing, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	created := filepath.Join(t.TempDir(), "nested", "tokens")
+
+	for _, dir := range []string{existing, created} {
+		if _, err := NewDiskTokenCache(dir); err != nil {
+			t.Fatal(err)
+		}
+		info, err := os.Stat(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if perm := info.Mode().Perm(); perm != 0o700 {
+			t.Errorf("%s permissions = %o, want 700", dir, perm)
+		}
+	}
+}
+
+func TestDiskTokenCacheClearInvalidatesEntry(t *testing.T) {
+	dir := t.TempDir()
+	disk, err := NewDiskTokenCache(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var fetches atomic.Int32
+	cache := newDiskBackedCache(disk, "oauth\x00secret", countingFetcher(&fetches))
+	if token, _ := cache.get(context.Background()); token != "token-1" {
+		t.Fatalf("get = %q, want token-1", token)
+	}
+
+	cache.clear()
+
+	if token, err := cache.get(context.Background()); err != nil || token != "token-2" {
+		t.Fatalf("get after clear = %q, %v; want token-2", token, err)
+	}
+
+	// Other instances pick up the new token rather than the cleared one
+	other := newDiskBackedCache(disk, "oauth\x00secret", countingFetcher(&fetches))
+	if token, _ := other.get(context.Background()); token != "token-2" {
+		t.Fatalf("other instance token = %q, want token-2", token)
+	}
+	if n := fetches.Load(); n != 2 {
+		t.Fatalf("fetched %d times, want 2", n)
+	}
+}
+
+func TestDiskTokenCacheLockSerializesFetches(t *testing.T) {
+	dir := t.TempDir()
+
+	var fetches atomic.Int32
+	fetch := func(ctx context.Context) (string, time.Time, error) {
+		n := fetches.Add(1)
+		time.Sleep(50 * time.Millisecond)
+		return fmt.Sprintf("token-%d", n), time.Now().Add(time.Hour), nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		disk, err := NewDiskTokenCache(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			token, _, err := disk.fetch(context.Background(), "oauth\x00secret", fetch, false)
+			if err != nil || token != "token-1" {
+				t.Errorf("fetch = %q, %v; want token-1", token, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n := fetches.Load(); n != 1 {
+		t.Fatalf("fetched %d times, want 1", n)
+	}
+}
+
+func TestDiskTokenCacheLockRespectsContext(t *testing.T) {
+	dir := t.TempDir()
+	disk, err := NewDiskTokenCache(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	unlock, err := lockFile(context.Background(), filepath.Join(dir, fingerprintFileName("oauth\x00secret")+".lock"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, _, err = disk.fetch(ctx, "oauth\x00secret", func(ctx context.Context) (string, time.Time, error) {
+		t.Error("fetched while the lock is held")
+		return "", time.Time{}, nil
+	}, false)
+	if err != context.DeadlineExceeded {
+		t.Fatalf("err = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
@@ -0,0 +1,130 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/tigusigalpa/yandex-cloud-client-go/errors"
+	"github.com/tigusigalpa/yandex-cloud-client-go/transport"
+)
+
+const (
+	// DefaultMetadataAddress is the address of the metadata service available
+	// on Compute instances, Cloud Functions and Serverless Containers
+	DefaultMetadataAddress = "169.254.169.254"
+
+	metadataTokenPath = "/computeMetadata/v1/instance/service-accounts/default/token"
+)
+
+// MetadataCredentials obtains IAM tokens of the service account attached to the instance,
+// function or container from the metadata service and refreshes them based on expires_in
+type MetadataCredentials struct {
+	cachedToken
+
+	address    string
+	httpClient *http.Client
+}
+
+// NewMetadataCredentials creates new metadata service credentials
+func NewMetadataCredentials(httpClient *http.Client) *MetadataCredentials {
+	if httpClient == nil {
+		httpClient = &http.Client{
+			Timeout: 10 * time.Second,
+		}
+	}
+
+	c := &MetadataCredentials{
+		address:    DefaultMetadataAddress,
+		httpClient: httpClient,
+	}
+	c.cachedToken = newCachedToken(c.fetchIAMToken, c.fingerprint)
+
+	return c
+}
+
+// SetAddress overrides the metadata service address: host[:port] or a base URL such as
+// an httptest server URL (must be called before the first request)
+func (c *MetadataCredentials) SetAddress(address string) {
+	c.address = address
+}
+
+// GetIAMToken gets a new IAM token from the metadata service
+func (c *MetadataCredentials) GetIAMToken(ctx context.Context) (string, error) {
+	token, _, err := c.fetchIAMToken(ctx)
+	return token, err
+}
+
+// fetchIAMToken requests a new IAM token and its expiration time from the metadata service
+func (c *MetadataCredentials) fetchIAMToken(ctx context.Context) (string, time.Time, error) {
+	ctx = transport.WithCallInfo(ctx, transport.CallInfo{
+		Service:       "metadata",
+		Resource:      "Tokens",
+		Method:        "Get",
+		TokenExchange: true,
+	})
+
+	req, err := http.NewRequestWithContext(ctx, "GET", c.tokenURL(), nil)
+	if err != nil {
+		return "", time.Time{}, errors.NewAuthenticationError("Failed to create request", err)
+	}
+
+	req.Header.Set("Metadata-Flavor", "Google")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", time.Time{}, errors.NewAuthenticationError("Failed to get IAM token from metadata service", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", time.Time{}, errors.NewAuthenticationError("Failed to read response", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", time.Time{}, errors.NewAuthenticationError(
+			"Failed to get IAM token from metadata service",
+			errors.NewAPIErrorFromHTTPResponse(resp, body),
+		)
+	}
+
+	var responseData struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+		TokenType   string `json:"token_type"`
+	}
+
+	if err := json.Unmarshal(body, &responseData); err != nil {
+		return "", time.Time{}, errors.NewAuthenticationError("Failed to parse response", err)
+	}
+
+	if responseData.AccessToken == "" {
+		return "", time.Time{}, errors.NewAuthenticationError("IAM token not found in response", nil)
+	}
+
+	var expiresAt time.Time
+	if responseData.ExpiresIn > 0 {
+		expiresAt = time.Now().Add(time.Duration(responseData.ExpiresIn) * time.Second)
+	}
+
+	return responseData.AccessToken, expiresAt, nil
+}
+
+// tokenURL returns the full URL of the metadata token endpoint
+func (c *MetadataCredentials) tokenURL() string {
+	address := strings.TrimSuffix(c.address, "/")
+	if !strings.Contains(address, "://") {
+		address = "http://" + address
+	}
+	return address + metadataTokenPath
+}
+
+// fingerprint identifies the credentials in the disk cache
+func (c *MetadataCredentials) fingerprint() string {
+	return credentialFingerprint("metadata", c.address)
+}
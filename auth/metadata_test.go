@@ -0,0 +1,128 @@
+package auth
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tigusigalpa/yandex-cloud-client-go/errors"
+)
+
+// newMetadataServer serves the metadata token endpoint with status and body
+// and records the Metadata-Flavor header of the last request
+func newMetadataServer(t *testing.T, status int, body string) (*httptest.Server, *string) {
+	flavor := new(string)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != metadataTokenPath {
+			http.NotFound(w, r)
+			return
+		}
+		*flavor = r.Header.Get("Metadata-Flavor")
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server, flavor
+}
+
+func TestMetadataCredentialsFetchesToken(t *testing.T) {
+	server, flavor := newMetadataServer(t, http.StatusOK, `{"access_token":"t1.metadata","expires_in":3600,"token_type":"Bearer"}`)
+
+	creds := NewMetadataCredentials(nil)
+	creds.SetAddress(server.URL)
+
+	before := time.Now()
+	token, expiresAt, err := creds.fetchIAMToken(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "t1.metadata" {
+		t.Errorf("token = %q, want t1.metadata", token)
+	}
+	if *flavor != "Google" {
+		t.Errorf("Metadata-Flavor = %q, want Google", *flavor)
+	}
+	if expiresAt.Before(before.Add(time.Hour)) || expiresAt.After(time.Now().Add(time.Hour)) {
+		t.Errorf("expiresAt = %v, want an hour from now", expiresAt)
+	}
+}
+
+func TestMetadataCredentialsAddress(t *testing.T) {
+	server, _ := newMetadataServer(t, http.StatusOK, `{"access_token":"t1.metadata","expires_in":3600}`)
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	for _, address := range []string{host, server.URL, server.URL + "/"} {
+		creds := NewMetadataCredentials(nil)
+		creds.SetAddress(address)
+
+		if got, want := creds.tokenURL(), server.URL+metadataTokenPath; got != want {
+			t.Errorf("address %q: tokenURL = %q, want %q", address, got, want)
+		}
+		if token, err := creds.GetIAMToken(context.Background()); err != nil || token != "t1.metadata" {
+			t.Errorf("address %q: GetIAMToken = %q, %v", address, token, err)
+		}
+	}
+
+	if got, want := NewMetadataCredentials(nil).tokenURL(), "http://"+DefaultMetadataAddress+metadataTokenPath; got != want {
+		t.Errorf("default tokenURL = %q, want %q", got, want)
+	}
+}
+
+func TestMetadataCredentialsWithoutExpiresIn(t *testing.T) {
+	server, _ := newMetadataServer(t, http.StatusOK, `{"access_token":"t1.metadata"}`)
+
+	creds := NewMetadataCredentials(nil)
+	creds.SetAddress(server.URL)
+
+	_, expiresAt, err := creds.fetchIAMToken(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !expiresAt.IsZero() {
+		t.Errorf("expiresAt = %v, want zero when expires_in is missing", expiresAt)
+	}
+}
+
+func TestMetadataCredentialsErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		code   errors.GRPCCode
+	}{
+		{"not found", http.StatusNotFound, `{"code":5,"message":"No service account attached"}`, errors.CodeNotFound},
+		{"server error", http.StatusInternalServerError, `internal error`, errors.CodeInternal},
+		{"malformed JSON", http.StatusOK, `{"access_token":`, errors.CodeOK},
+		{"missing token", http.StatusOK, `{"expires_in":3600}`, errors.CodeOK},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server, _ := newMetadataServer(t, test.status, test.body)
+
+			creds := NewMetadataCredentials(nil)
+			creds.SetAddress(server.URL)
+
+			_, err := creds.GetIAMToken(context.Background())
+			var authErr *errors.AuthenticationError
+			if !stderrors.As(err, &authErr) {
+				t.Fatalf("error = %v, want an AuthenticationError", err)
+			}
+
+			var apiErr *errors.APIError
+			if test.status == http.StatusOK {
+				if stderrors.As(err, &apiErr) {
+					t.Errorf("error = %v, want no APIError for a %s response", err, test.name)
+				}
+				return
+			}
+			if !stderrors.As(err, &apiErr) || apiErr.StatusCode != test.status || apiErr.GRPCCode != test.code {
+				t.Errorf("error = %v, want an APIError with status %d and code %s", err, test.status, test.code)
+			}
+		})
+	}
+}
@@ -0,0 +1,193 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/tigusigalpa/yandex-cloud-client-go/errors"
+)
+
+const jwtLifetime = time.Hour
+
+// ServiceAccountKey is a service account authorized key (as created by `yc iam key create`)
+type ServiceAccountKey struct {
+	ID               string `json:"id" yaml:"id"`
+	ServiceAccountID string `json:"service_account_id" yaml:"service_account_id"`
+	CreatedAt        string `json:"created_at,omitempty" yaml:"created_at,omitempty"`
+	KeyAlgorithm     string `json:"key_algorithm,omitempty" yaml:"key_algorithm,omitempty"`
+	PublicKey        string `json:"public_key,omitempty" yaml:"public_key,omitempty"`
+	PrivateKey       string `json:"private_key" yaml:"private_key"`
+}
+
+// ParseServiceAccountKey parses an authorized key JSON
+func ParseServiceAccountKey(data []byte) (*ServiceAccountKey, error) {
+	var key ServiceAccountKey
+	if err := json.Unmarshal(data, &key); err != nil {
+		return nil, errors.NewAuthenticationError("Failed to parse service account key", err)
+	}
+	return &key, nil
+}
+
+// LoadServiceAccountKeyFile reads and parses an authorized key JSON file
+func LoadServiceAccountKeyFile(path string) (*ServiceAccountKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.NewAuthenticationError("Failed to read service account key file", err)
+	}
+	return ParseServiceAccountKey(data)
+}
+
+// ServiceAccountKeyCredentials exchanges a PS256-signed JWT for an IAM token
+// and manages the token lifecycle including caching and auto-refresh
+type ServiceAccountKeyCredentials struct {
+	cachedToken
+
+	keyID            string
+	serviceAccountID string
+	privateKey       *rsa.PrivateKey
+	tokenEndpoint    string
+	httpClient       *http.Client
+}
+
+// NewServiceAccountKeyCredentials creates new service account key credentials
+func NewServiceAccountKeyCredentials(key *ServiceAccountKey, httpClient *http.Client) (*ServiceAccountKeyCredentials, error) {
+	if key == nil || key.ID == "" {
+		return nil, errors.NewAuthenticationError("Service account key ID cannot be empty", nil)
+	}
+
+	if key.ServiceAccountID == "" {
+		return nil, errors.NewAuthenticationError("Service account ID cannot be empty", nil)
+	}
+
+	privateKey, err := parseRSAPrivateKey(key.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if httpClient == nil {
+		httpClient = &http.Client{
+			Timeout: 30 * time.Second,
+		}
+	}
+
+	c := &ServiceAccountKeyCredentials{
+		keyID:            key.ID,
+		serviceAccountID: key.ServiceAccountID,
+		privateKey:       privateKey,
+		tokenEndpoint:    iamTokenEndpoint,
+		httpClient:       httpClient,
+	}
+	c.cachedToken = newCachedToken(c.fetchIAMToken, c.fingerprint)
+
+	return c, nil
+}
+
+// SetTokenEndpoint overrides the IAM token exchange endpoint (must be called before the first request).
+// The JWT audience always stays the public IAM token endpoint.
+func (c *ServiceAccountKeyCredentials) SetTokenEndpoint(endpoint string) {
+	c.tokenEndpoint = endpoint
+}
+
+// GetIAMToken gets a new IAM token using a signed JWT
+func (c *ServiceAccountKeyCredentials) GetIAMToken(ctx context.Context) (string, error) {
+	token, _, err := c.fetchIAMToken(ctx)
+	return token, err
+}
+
+// fetchIAMToken exchanges a signed JWT for a new IAM token and its expiration time
+func (c *ServiceAccountKeyCredentials) fetchIAMToken(ctx context.Context) (string, time.Time, error) {
+	jwt, err := c.SignedJWT(time.Now())
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	requestBody := map[string]string{
+		"jwt": jwt,
+	}
+
+	return exchangeIAMToken(ctx, c.httpClient, c.tokenEndpoint, requestBody)
+}
+
+// SignedJWT creates a PS256-signed JWT issued at now
+func (c *ServiceAccountKeyCredentials) SignedJWT(now time.Time) (string, error) {
+	header := map[string]string{
+		"typ": "JWT",
+		"alg": "PS256",
+		"kid": c.keyID,
+	}
+
+	claims := map[string]interface{}{
+		"iss": c.serviceAccountID,
+		"aud": iamTokenEndpoint,
+		"iat": now.Unix(),
+		"exp": now.Add(jwtLifetime).Unix(),
+	}
+
+	headerJSON, err := json.Marshal(header)
+	if err != nil {
+		return "", errors.NewAuthenticationError("Failed to marshal JWT header", err)
+	}
+
+	claimsJSON, err := json.Marshal(claims)
+	if err != nil {
+		return "", errors.NewAuthenticationError("Failed to marshal JWT claims", err)
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(headerJSON) + "." +
+		base64.RawURLEncoding.EncodeToString(claimsJSON)
+
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPSS(rand.Reader, c.privateKey, crypto.SHA256, digest[:], &rsa.PSSOptions{
+		SaltLength: rsa.PSSSaltLengthEqualsHash,
+	})
+	if err != nil {
+		return "", errors.NewAuthenticationError("Failed to sign JWT", err)
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// GetServiceAccountID returns the service account ID
+func (c *ServiceAccountKeyCredentials) GetServiceAccountID() string {
+	return c.serviceAccountID
+}
+
+// parseRSAPrivateKey parses a PEM-encoded (PKCS#8 or PKCS#1) RSA private key
+func parseRSAPrivateKey(privateKeyPEM string) (*rsa.PrivateKey, error) {
+	// pem.Decode skips text before the PEM block (e.g. the "PLEASE DO NOT REMOVE THIS LINE!" header)
+	block, _ := pem.Decode([]byte(privateKeyPEM))
+	if block == nil {
+		return nil, errors.NewAuthenticationError("Failed to decode service account private key PEM", nil)
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.NewAuthenticationError("Failed to parse service account private key", err)
+	}
+
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.NewAuthenticationError("Service account private key is not an RSA key", nil)
+	}
+
+	return key, nil
+}
+
+// fingerprint identifies the credentials in the disk cache
+func (c *ServiceAccountKeyCredentials) fingerprint() string {
+	return credentialFingerprint("service-account-key", c.tokenEndpoint, c.serviceAccountID, c.keyID)
+}
@@ -0,0 +1,144 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testServiceAccountKey generates an authorized key JSON with a PKCS#8 private key
+func testServiceAccountKey(t *testing.T) ([]byte, *rsa.PrivateKey) {
+	t.Helper()
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := json.Marshal(map[string]string{
+		"id":                 "ajekey",
+		"service_account_id": "ajesa",
+		"key_algorithm":      "RSA_2048",
+		"private_key":        "PLEASE DO NOT REMOVE THIS LINE! Yandex.Cloud SA Key ID <ajekey>\n" + string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return data, privateKey
+}
+
+func TestSignedJWT(t *testing.T) {
+	data, privateKey := testServiceAccountKey(t)
+
+	key, err := ParseServiceAccountKey(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	creds, err := NewServiceAccountKeyCredentials(key, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Unix(1700000000, 0)
+	jwt, err := creds.SignedJWT(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parts := strings.Split(jwt, ".")
+	if len(parts) != 3 {
+		t.Fatalf("JWT has %d parts, want 3", len(parts))
+	}
+
+	var header map[string]string
+	decodeJWTPart(t, parts[0], &header)
+	if header["alg"] != "PS256" || header["typ"] != "JWT" || header["kid"] != "ajekey" {
+		t.Errorf("header = %v", header)
+	}
+
+	var claims struct {
+		Iss string `json:"iss"`
+		Aud string `json:"aud"`
+		Iat int64  `json:"iat"`
+		Exp int64  `json:"exp"`
+	}
+	decodeJWTPart(t, parts[1], &claims)
+	if claims.Iss != "ajesa" || claims.Aud != iamTokenEndpoint {
+		t.Errorf("iss = %q, aud = %q", claims.Iss, claims.Aud)
+	}
+	if claims.Iat != now.Unix() || claims.Exp != now.Add(jwtLifetime).Unix() {
+		t.Errorf("iat = %d, exp = %d", claims.Iat, claims.Exp)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	err = rsa.VerifyPSS(&privateKey.PublicKey, crypto.SHA256, digest[:], signature, &rsa.PSSOptions{
+		SaltLength: rsa.PSSSaltLengthEqualsHash,
+	})
+	if err != nil {
+		t.Fatalf("signature does not verify: %v", err)
+	}
+}
+
+func TestParseRSAPrivateKey(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pkcs1 := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})
+	if parsed, err := parseRSAPrivateKey(string(pkcs1)); err != nil || !parsed.Equal(privateKey) {
+		t.Fatalf("PKCS#1: parsed = %v, err = %v", parsed != nil, err)
+	}
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(ecKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := parseRSAPrivateKey(string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))); err == nil {
+		t.Fatal("ECDSA key accepted")
+	}
+
+	if _, err := parseRSAPrivateKey("not a key"); err == nil {
+		t.Fatal("invalid PEM accepted")
+	}
+}
+
+// decodeJWTPart decodes a base64url JSON part of a JWT into v
+func decodeJWTPart(t *testing.T, part string, v interface{}) {
+	t.Helper()
+
+	data, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,329 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/tigusigalpa/yandex-cloud-client-go/errors"
+	"github.com/tigusigalpa/yandex-cloud-client-go/transport"
+)
+
+const (
+	// refreshTimeout bounds a single token refresh shared by concurrent callers
+	refreshTimeout = 30 * time.Second
+	// refreshRetryInterval is the delay before retrying a failed refresh while the old token is still valid
+	refreshRetryInterval = 10 * time.Second
+)
+
+// tokenFetcher obtains a new IAM token and its expiration time (zero if unknown)
+type tokenFetcher func(ctx context.Context) (string, time.Time, error)
+
+// tokenCache caches an IAM token and refreshes it ahead of expiry.
+// Concurrent refreshes are coalesced into a single in-flight request, and the old token
+// is served while it is still valid, even if a refresh attempt fails.
+type tokenCache struct {
+	fetch       tokenFetcher
+	fingerprint func() string
+	disk        *DiskTokenCache
+	token       string
+	expiresAt   time.Time
+	refreshAt   time.Time
+	inflight    *refreshCall
+	invalidated bool
+	mu          sync.Mutex
+}
+
+// refreshCall is an in-flight token refresh
+type refreshCall struct {
+	done chan struct{}
+	err  error
+}
+
+// newTokenCache creates a new token cache
+func newTokenCache(fetch tokenFetcher) *tokenCache {
+	return &tokenCache{
+		fetch: fetch,
+	}
+}
+
+// get returns a valid IAM token (with auto-refresh)
+func (c *tokenCache) get(ctx context.Context) (string, error) {
+	now := time.Now()
+
+	c.mu.Lock()
+	if c.token != "" && now.Before(c.expiresAt) {
+		// Refresh ahead of expiry without blocking the caller
+		if !now.Before(c.refreshAt) {
+			c.startRefreshLocked(ctx)
+		}
+		token := c.token
+		c.mu.Unlock()
+		return token, nil
+	}
+	call := c.startRefreshLocked(ctx)
+	c.mu.Unlock()
+
+	select {
+	case <-call.done:
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+
+	if call.err != nil {
+		return "", call.err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.token, nil
+}
+
+// startRefreshLocked starts a refresh unless one is already in flight (c.mu must be held)
+func (c *tokenCache) startRefreshLocked(ctx context.Context) *refreshCall {
+	if c.inflight != nil {
+		return c.inflight
+	}
+
+	call := &refreshCall{
+		done: make(chan struct{}),
+	}
+	c.inflight = call
+
+	// A cleared token must not come back from the disk cache
+	invalidate := c.invalidated
+	c.invalidated = false
+
+	go c.refresh(ctx, call, invalidate)
+
+	return call
+}
+
+// refresh fetches a new IAM token and completes call, bypassing the disk cache entry if invalidate is set.
+// The refresh is shared by concurrent callers, so it runs detached from the request that triggered it:
+// neither its cancellation nor its values (response metadata, request and idempotency keys) apply,
+// and its trace is only linked.
+func (c *tokenCache) refresh(trigger context.Context, call *refreshCall, invalidate bool) {
+	ctx, cancel := context.WithTimeout(transport.WithLinkedContext(context.Background(), trigger), refreshTimeout)
+	defer cancel()
+
+	token, expiresAt, err := c.fetchToken(ctx, invalidate)
+	now := time.Now()
+
+	c.mu.Lock()
+	if err == nil {
+		if expiresAt.IsZero() {
+			expiresAt = now.Add(tokenLifetime)
+		}
+		c.token = token
+		c.expiresAt = expiresAt
+		c.refreshAt = refreshTime(now, expiresAt)
+	} else {
+		c.invalidated = c.invalidated || invalidate
+		if c.token != "" && now.Before(c.expiresAt) {
+			// Keep serving the old token and retry later
+			c.refreshAt = now.Add(refreshRetryInterval)
+			if c.refreshAt.After(c.expiresAt) {
+				c.refreshAt = c.expiresAt
+			}
+		}
+	}
+	call.err = err
+	c.inflight = nil
+	c.mu.Unlock()
+
+	close(call.done)
+}
+
+// fetchToken fetches a new IAM token, through the disk cache if one is set
+func (c *tokenCache) fetchToken(ctx context.Context, invalidate bool) (string, time.Time, error) {
+	if c.disk == nil {
+		return c.fetch(ctx)
+	}
+	return c.disk.fetch(ctx, c.fingerprint(), c.fetch, invalidate)
+}
+
+// refreshTime returns when a token expiring at expiresAt should be refreshed
+func refreshTime(now, expiresAt time.Time) time.Time {
+	refreshAt := expiresAt.Add(-tokenRefreshMargin)
+	if refreshAt.Before(now) {
+		// Short-lived token: refresh halfway through its lifetime
+		refreshAt = now.Add(expiresAt.Sub(now) / 2)
+	}
+	return refreshAt
+}
+
+// refreshLoop proactively refreshes the token ahead of expiry until ctx is done
+func (c *tokenCache) refreshLoop(ctx context.Context) {
+	for {
+		c.mu.Lock()
+		wait := time.Until(c.refreshAt)
+		c.mu.Unlock()
+
+		if err := sleep(ctx, wait); err != nil {
+			return
+		}
+
+		c.mu.Lock()
+		call := c.startRefreshLocked(ctx)
+		c.mu.Unlock()
+
+		select {
+		case <-call.done:
+		case <-ctx.Done():
+			return
+		}
+
+		if call.err != nil {
+			if err := sleep(ctx, refreshRetryInterval); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// sleep waits for d or until ctx is done
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// clear clears the cached IAM token and invalidates its disk cache entry (force refresh on next request)
+func (c *tokenCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.invalidated = true
+	c.token = ""
+	c.expiresAt = time.Time{}
+	c.refreshAt = time.Time{}
+}
+
+// valid checks if cached IAM token is still valid
+func (c *tokenCache) valid() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.token != "" && time.Now().Before(c.expiresAt)
+}
+
+// cachedToken implements the token caching methods shared by the credential providers
+type cachedToken struct {
+	cache *tokenCache
+}
+
+// newCachedToken creates a token cache for credentials identified in the disk cache by fingerprint
+func newCachedToken(fetch tokenFetcher, fingerprint func() string) cachedToken {
+	cache := newTokenCache(fetch)
+	cache.fingerprint = fingerprint
+	return cachedToken{cache: cache}
+}
+
+// GetValidIAMToken returns a valid IAM token (with auto-refresh)
+func (t *cachedToken) GetValidIAMToken(ctx context.Context) (string, error) {
+	return t.cache.get(ctx)
+}
+
+// SetDiskCache shares IAM tokens with other processes through cache (must be called before the first request)
+func (t *cachedToken) SetDiskCache(cache *DiskTokenCache) {
+	t.cache.disk = cache
+}
+
+// StartBackgroundRefresh refreshes the IAM token ahead of expiry in a background goroutine until ctx is done
+func (t *cachedToken) StartBackgroundRefresh(ctx context.Context) {
+	go t.cache.refreshLoop(ctx)
+}
+
+// ClearCache clears the cached IAM token, including the disk cache entry (force refresh on next request)
+func (t *cachedToken) ClearCache() {
+	t.cache.clear()
+}
+
+// HasValidCachedToken checks if cached IAM token is still valid
+func (t *cachedToken) HasValidCachedToken() bool {
+	return t.cache.valid()
+}
+
+// newTokenExchangeRequest creates a token exchange POST request described by info.
+// Token exchange has no side effects, so the request is marked as safe to retry.
+func newTokenExchangeRequest(ctx context.Context, info transport.CallInfo, endpoint string, body io.Reader) (*http.Request, error) {
+	info.TokenExchange = true
+	ctx = transport.WithIdempotent(transport.WithCallInfo(ctx, info))
+
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, body)
+	if err != nil {
+		return nil, errors.NewAuthenticationError("Failed to create request", err)
+	}
+	return req, nil
+}
+
+// exchangeIAMToken requests a new IAM token and its expiration time from the IAM token endpoint
+func exchangeIAMToken(ctx context.Context, httpClient *http.Client, endpoint string, requestBody interface{}) (string, time.Time, error) {
+	jsonData, err := json.Marshal(requestBody)
+	if err != nil {
+		return "", time.Time{}, errors.NewAuthenticationError("Failed to marshal request", err)
+	}
+
+	req, err := newTokenExchangeRequest(ctx, transport.CallInfo{
+		Service:  "iam",
+		Resource: "IAMTokens",
+		Method:   "Create",
+	}, endpoint, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", time.Time{}, errors.NewAuthenticationError("Failed to get IAM token", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", time.Time{}, errors.NewAuthenticationError("Failed to read response", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", time.Time{}, errors.NewAuthenticationError(
+			"Failed to get IAM token",
+			errors.NewAPIErrorFromHTTPResponse(resp, body),
+		)
+	}
+
+	var responseData struct {
+		IAMToken  string `json:"iamToken"`
+		ExpiresAt string `json:"expiresAt"`
+	}
+
+	if err := json.Unmarshal(body, &responseData); err != nil {
+		return "", time.Time{}, errors.NewAuthenticationError("Failed to parse response", err)
+	}
+
+	if responseData.IAMToken == "" {
+		return "", time.Time{}, errors.NewAuthenticationError("IAM token not found in response", nil)
+	}
+
+	// Unknown expiry falls back to the default token lifetime
+	expiresAt, _ := time.Parse(time.RFC3339Nano, responseData.ExpiresAt)
+
+	return responseData.IAMToken, expiresAt, nil
+}
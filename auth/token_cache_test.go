@@ -0,0 +1,131 @@
+package auth
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/tigusigalpa/yandex-cloud-client-go/transport"
+)
+
+type testContextKey struct{}
+
+func TestTokenCacheCoalescesRefreshes(t *testing.T) {
+	var fetches atomic.Int32
+	cache := newTokenCache(func(ctx context.Context) (string, time.Time, error) {
+		n := fetches.Add(1)
+		time.Sleep(20 * time.Millisecond)
+		return fmt.Sprintf("token-%d", n), time.Now().Add(time.Hour), nil
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			token, err := cache.get(context.Background())
+			if err != nil || token != "token-1" {
+				t.Errorf("get = %q, %v; want token-1", token, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n := fetches.Load(); n != 1 {
+		t.Fatalf("fetched %d times, want 1", n)
+	}
+}
+
+func TestTokenCacheServesOldTokenWhenRefreshFails(t *testing.T) {
+	var fetches atomic.Int32
+	refreshed := make(chan struct{}, 10)
+	cache := newTokenCache(func(ctx context.Context) (string, time.Time, error) {
+		defer func() { refreshed <- struct{}{} }()
+		if fetches.Add(1) == 1 {
+			return "old", time.Now().Add(time.Hour), nil
+		}
+		return "", time.Time{}, stderrors.New("IAM unavailable")
+	})
+
+	if token, err := cache.get(context.Background()); err != nil || token != "old" {
+		t.Fatalf("get = %q, %v; want old", token, err)
+	}
+	<-refreshed
+
+	// Make the token due for refresh while still valid
+	cache.mu.Lock()
+	cache.refreshAt = time.Now().Add(-time.Second)
+	cache.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if token, err := cache.get(context.Background()); err != nil || token != "old" {
+				t.Errorf("get = %q, %v; want old", token, err)
+			}
+		}()
+	}
+	wg.Wait()
+	<-refreshed
+
+	if token, err := cache.get(context.Background()); err != nil || token != "old" {
+		t.Fatalf("get after failed refresh = %q, %v; want old", token, err)
+	}
+	if n := fetches.Load(); n != 2 {
+		t.Fatalf("fetched %d times, want 2 (failed refresh retried later)", n)
+	}
+
+	cache.mu.Lock()
+	retryAt := cache.refreshAt
+	cache.mu.Unlock()
+	if time.Until(retryAt) <= 0 {
+		t.Fatal("failed refresh is not postponed")
+	}
+}
+
+func TestTokenCacheRefreshIsDetachedFromCaller(t *testing.T) {
+	fetched := make(chan context.Context, 1)
+	release := make(chan struct{})
+	cache := newTokenCache(func(ctx context.Context) (string, time.Time, error) {
+		fetched <- ctx
+		<-release
+		return "token", time.Now().Add(time.Hour), nil
+	})
+
+	md := &transport.ResponseMetadata{}
+	ctx := context.WithValue(context.Background(), testContextKey{}, "caller")
+	ctx = transport.WithResponseMetadata(ctx, md)
+	ctx = transport.WithIdempotencyKey(ctx, "key-1")
+	ctx, cancel := context.WithCancel(ctx)
+
+	go cache.get(ctx)
+	refreshCtx := <-fetched
+	cancel()
+
+	if refreshCtx.Value(testContextKey{}) != nil {
+		t.Error("refresh context carries caller values")
+	}
+	if _, ok := transport.IdempotencyKeyFromContext(refreshCtx); ok {
+		t.Error("refresh context carries the caller idempotency key")
+	}
+	if linked, ok := transport.LinkedContextFromContext(refreshCtx); !ok || linked.Value(testContextKey{}) != "caller" {
+		t.Error("refresh context is not linked to the caller context")
+	}
+	if refreshCtx.Err() != nil {
+		t.Error("refresh is cancelled together with the caller")
+	}
+	if _, ok := refreshCtx.Deadline(); !ok {
+		t.Error("refresh has no timeout")
+	}
+
+	close(release)
+	if token, err := cache.get(context.Background()); err != nil || token != "token" {
+		t.Fatalf("get = %q, %v; want token", token, err)
+	}
+}
@@ -0,0 +1,172 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/tigusigalpa/yandex-cloud-client-go/errors"
+	"github.com/tigusigalpa/yandex-cloud-client-go/transport"
+)
+
+// DefaultSTSEndpoint is the Security Token Service endpoint used for workload identity federation
+const DefaultSTSEndpoint = "https://auth.yandex.cloud/oauth/token"
+
+// Token exchange parameters (RFC 8693)
+const (
+	tokenExchangeGrantType = "urn:ietf:params:oauth:grant-type:token-exchange"
+	accessTokenType        = "urn:ietf:params:oauth:token-type:access_token"
+	idTokenType            = "urn:ietf:params:oauth:token-type:id_token"
+)
+
+// SubjectTokenSource returns the current external OIDC token (e.g. a GitHub Actions ID token
+// or a Kubernetes service account token) to be exchanged for an IAM token
+type SubjectTokenSource func(ctx context.Context) (string, error)
+
+// SubjectTokenFromFile returns a source reading the subject token from path on every exchange,
+// so that rotated tokens (e.g. Kubernetes projected volumes) are picked up
+func SubjectTokenFromFile(path string) SubjectTokenSource {
+	return func(ctx context.Context) (string, error) {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", errors.NewAuthenticationError("Failed to read subject token file", err)
+		}
+		return strings.TrimSpace(string(data)), nil
+	}
+}
+
+// WorkloadIdentityCredentials exchanges an external OIDC token for an IAM token of a federated
+// service account via the STS token exchange endpoint and manages the token lifecycle
+type WorkloadIdentityCredentials struct {
+	cachedToken
+
+	serviceAccountID string
+	subjectToken     SubjectTokenSource
+	stsEndpoint      string
+	httpClient       *http.Client
+}
+
+// NewWorkloadIdentityCredentials creates new workload identity federation credentials
+func NewWorkloadIdentityCredentials(serviceAccountID string, subjectToken SubjectTokenSource, httpClient *http.Client) (*WorkloadIdentityCredentials, error) {
+	if serviceAccountID == "" {
+		return nil, errors.NewAuthenticationError("Service account ID cannot be empty", nil)
+	}
+
+	if subjectToken == nil {
+		return nil, errors.NewAuthenticationError("Subject token source cannot be nil", nil)
+	}
+
+	if httpClient == nil {
+		httpClient = &http.Client{
+			Timeout: 30 * time.Second,
+		}
+	}
+
+	c := &WorkloadIdentityCredentials{
+		serviceAccountID: serviceAccountID,
+		subjectToken:     subjectToken,
+		stsEndpoint:      DefaultSTSEndpoint,
+		httpClient:       httpClient,
+	}
+	c.cachedToken = newCachedToken(c.fetchIAMToken, c.fingerprint)
+
+	return c, nil
+}
+
+// SetSTSEndpoint overrides the token exchange endpoint (must be called before the first request)
+func (c *WorkloadIdentityCredentials) SetSTSEndpoint(endpoint string) {
+	c.stsEndpoint = endpoint
+}
+
+// GetIAMToken exchanges the current subject token for a new IAM token
+func (c *WorkloadIdentityCredentials) GetIAMToken(ctx context.Context) (string, error) {
+	token, _, err := c.fetchIAMToken(ctx)
+	return token, err
+}
+
+// fetchIAMToken exchanges the subject token and returns the IAM token and its expiration time
+func (c *WorkloadIdentityCredentials) fetchIAMToken(ctx context.Context) (string, time.Time, error) {
+	subjectToken, err := c.subjectToken(ctx)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	if subjectToken == "" {
+		return "", time.Time{}, errors.NewAuthenticationError("Subject token cannot be empty", nil)
+	}
+
+	form := url.Values{
+		"grant_type":           {tokenExchangeGrantType},
+		"requested_token_type": {accessTokenType},
+		"audience":             {c.serviceAccountID},
+		"subject_token":        {subjectToken},
+		"subject_token_type":   {idTokenType},
+	}
+
+	req, err := newTokenExchangeRequest(ctx, transport.CallInfo{
+		Service:  "sts",
+		Resource: "Tokens",
+		Method:   "Exchange",
+	}, c.stsEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", time.Time{}, errors.NewAuthenticationError("Failed to exchange subject token", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", time.Time{}, errors.NewAuthenticationError("Failed to read response", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", time.Time{}, errors.NewAuthenticationError(
+			"Failed to exchange subject token",
+			errors.NewAPIErrorFromHTTPResponse(resp, body),
+		)
+	}
+
+	var responseData struct {
+		AccessToken     string `json:"access_token"`
+		IssuedTokenType string `json:"issued_token_type"`
+		TokenType       string `json:"token_type"`
+		ExpiresIn       int64  `json:"expires_in"`
+	}
+
+	if err := json.Unmarshal(body, &responseData); err != nil {
+		return "", time.Time{}, errors.NewAuthenticationError("Failed to parse response", err)
+	}
+
+	if responseData.AccessToken == "" {
+		return "", time.Time{}, errors.NewAuthenticationError("IAM token not found in response", nil)
+	}
+
+	var expiresAt time.Time
+	if responseData.ExpiresIn > 0 {
+		expiresAt = time.Now().Add(time.Duration(responseData.ExpiresIn) * time.Second)
+	}
+
+	return responseData.AccessToken, expiresAt, nil
+}
+
+// GetServiceAccountID returns the federated service account ID
+func (c *WorkloadIdentityCredentials) GetServiceAccountID() string {
+	return c.serviceAccountID
+}
+
+// fingerprint identifies the credentials in the disk cache
+func (c *WorkloadIdentityCredentials) fingerprint() string {
+	return credentialFingerprint("workload-identity", c.stsEndpoint, c.serviceAccountID)
+}
@@ -0,0 +1,198 @@
+package auth
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tigusigalpa/yandex-cloud-client-go/errors"
+)
+
+// stsServer is a token exchange endpoint responding with status and body
+// that records the forms of received requests
+type stsServer struct {
+	*httptest.Server
+
+	mu    sync.Mutex
+	forms []url.Values
+}
+
+func newSTSServer(t *testing.T, status int, body string) *stsServer {
+	s := &stsServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Type") != "application/x-www-form-urlencoded" {
+			http.Error(w, "unexpected content type", http.StatusUnsupportedMediaType)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		s.mu.Lock()
+		s.forms = append(s.forms, r.PostForm)
+		s.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// subjectTokens returns the subject tokens of the received requests
+func (s *stsServer) subjectTokens() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tokens := make([]string, len(s.forms))
+	for i, form := range s.forms {
+		tokens[i] = form.Get("subject_token")
+	}
+	return tokens
+}
+
+// newTestWorkloadIdentity creates workload identity credentials exchanging tokens at server
+func newTestWorkloadIdentity(t *testing.T, server *stsServer, subjectToken SubjectTokenSource) *WorkloadIdentityCredentials {
+	t.Helper()
+
+	creds, err := NewWorkloadIdentityCredentials("ajefederated", subjectToken, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	creds.SetSTSEndpoint(server.URL)
+	return creds
+}
+
+// staticSubjectToken returns a source of a fixed subject token
+func staticSubjectToken(token string) SubjectTokenSource {
+	return func(ctx context.Context) (string, error) {
+		return token, nil
+	}
+}
+
+func TestWorkloadIdentityExchange(t *testing.T) {
+	server := newSTSServer(t, http.StatusOK, `{"access_token":"t1.federated","issued_token_type":"urn:ietf:params:oauth:token-type:access_token","token_type":"Bearer","expires_in":3600}`)
+	creds := newTestWorkloadIdentity(t, server, staticSubjectToken("oidc-token"))
+
+	before := time.Now()
+	token, expiresAt, err := creds.fetchIAMToken(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "t1.federated" {
+		t.Errorf("token = %q, want t1.federated", token)
+	}
+	if expiresAt.Before(before.Add(time.Hour)) || expiresAt.After(time.Now().Add(time.Hour)) {
+		t.Errorf("expiresAt = %v, want an hour from now", expiresAt)
+	}
+
+	if len(server.forms) != 1 {
+		t.Fatalf("received %d requests, want 1", len(server.forms))
+	}
+	want := map[string]string{
+		"grant_type":           "urn:ietf:params:oauth:grant-type:token-exchange",
+		"requested_token_type": "urn:ietf:params:oauth:token-type:access_token",
+		"audience":             "ajefederated",
+		"subject_token":        "oidc-token",
+		"subject_token_type":   "urn:ietf:params:oauth:token-type:id_token",
+	}
+	form := server.forms[0]
+	for field, value := range want {
+		if got := form.Get(field); got != value {
+			t.Errorf("%s = %q, want %q", field, got, value)
+		}
+	}
+	if len(form) != len(want) {
+		t.Errorf("form = %v, want only %d fields", form, len(want))
+	}
+}
+
+func TestSubjectTokenFromFileRereadsFile(t *testing.T) {
+	server := newSTSServer(t, http.StatusOK, `{"access_token":"t1.federated","expires_in":3600}`)
+
+	path := filepath.Join(t.TempDir(), "token")
+	creds := newTestWorkloadIdentity(t, server, SubjectTokenFromFile(path))
+
+	for _, content := range []string{"first-token\n", "  rotated-token \r\n"} {
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := creds.GetIAMToken(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tokens := server.subjectTokens()
+	if len(tokens) != 2 || tokens[0] != "first-token" || tokens[1] != "rotated-token" {
+		t.Errorf("subject tokens = %q, want [first-token rotated-token]", tokens)
+	}
+}
+
+func TestWorkloadIdentitySubjectTokenErrors(t *testing.T) {
+	server := newSTSServer(t, http.StatusOK, `{"access_token":"t1.federated"}`)
+
+	missing := newTestWorkloadIdentity(t, server, SubjectTokenFromFile(filepath.Join(t.TempDir(), "missing")))
+	empty := newTestWorkloadIdentity(t, server, staticSubjectToken(""))
+
+	for name, creds := range map[string]*WorkloadIdentityCredentials{"missing file": missing, "empty token": empty} {
+		var authErr *errors.AuthenticationError
+		if _, err := creds.GetIAMToken(context.Background()); !stderrors.As(err, &authErr) {
+			t.Errorf("%s: error = %v, want an AuthenticationError", name, err)
+		}
+	}
+	if tokens := server.subjectTokens(); len(tokens) != 0 {
+		t.Errorf("exchanged subject tokens %q, want no exchange", tokens)
+	}
+}
+
+func TestWorkloadIdentityExchangeErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		code    errors.GRPCCode
+		message string
+	}{
+		{"rejected", http.StatusBadRequest, `{"code":3,"message":"Invalid subject token"}`, errors.CodeInvalidArgument, "Invalid subject token"},
+		{"unauthorized", http.StatusUnauthorized, `{"code":16,"message":"Federation not found"}`, errors.CodeUnauthenticated, "Federation not found"},
+		{"malformed JSON", http.StatusOK, `{"access_token":`, errors.CodeOK, ""},
+		{"missing token", http.StatusOK, `{"expires_in":3600}`, errors.CodeOK, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newSTSServer(t, test.status, test.body)
+			creds := newTestWorkloadIdentity(t, server, staticSubjectToken("oidc-token"))
+
+			_, err := creds.GetIAMToken(context.Background())
+			var authErr *errors.AuthenticationError
+			if !stderrors.As(err, &authErr) {
+				t.Fatalf("error = %v, want an AuthenticationError", err)
+			}
+
+			var apiErr *errors.APIError
+			if test.status == http.StatusOK {
+				if stderrors.As(err, &apiErr) {
+					t.Errorf("error = %v, want no APIError for a %s response", err, test.name)
+				}
+				return
+			}
+			if !stderrors.As(err, &apiErr) || apiErr.StatusCode != test.status || apiErr.GRPCCode != test.code {
+				t.Errorf("error = %v, want an APIError with status %d and code %s", err, test.status, test.code)
+			}
+			if !strings.Contains(err.Error(), test.message) {
+				t.Errorf("error = %v, want the error body message %q", err, test.message)
+			}
+		})
+	}
+}
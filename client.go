@@ -6,92 +6,243 @@ import (
 	"github.com/tigusigalpa/yandex-cloud-client-go/auth"
 	"github.com/tigusigalpa/yandex-cloud-client-go/errors"
 	"github.com/tigusigalpa/yandex-cloud-client-go/resources"
+	"github.com/tigusigalpa/yandex-cloud-client-go/transport"
 )
 
+// Base URIs of Yandex Cloud services
 const (
-	iamBaseURI             = "https://iam.api.cloud.yandex.net/"
-	organizationBaseURI    = "https://organization-manager.api.cloud.yandex.net/"
-	resourceManagerBaseURI = "https://resource-manager.api.cloud.yandex.net/"
+	IAMBaseURI             = "https://iam.api.cloud.yandex.net/"
+	OrganizationBaseURI    = "https://organization-manager.api.cloud.yandex.net/"
+	ResourceManagerBaseURI = "https://resource-manager.api.cloud.yandex.net/"
+	OperationBaseURI       = "https://operation.api.cloud.yandex.net/"
 )
 
 // Client is the main client for Yandex Cloud API
 type Client struct {
 	httpClient  *http.Client
+	credentials auth.Credentials
 	authManager *auth.IAMTokenManager
+	endpoints   map[Service]string
+	pollPolicy  resources.PollPolicy
 }
 
 // NewClient creates a new Yandex Cloud client
-func NewClient(oauthToken string, httpClient *http.Client) (*Client, error) {
-	if oauthToken == "" {
-		return nil, errors.NewAuthenticationError("OAuth token cannot be empty", nil)
+func NewClient(oauthToken string, httpClient *http.Client, opts ...Option) (*Client, error) {
+	opts = append([]Option{WithOAuthToken(oauthToken), WithHTTPClient(httpClient)}, opts...)
+	return NewClientWithOptions(opts...)
+}
+
+// NewClientWithOptions creates a new Yandex Cloud client configured with functional options
+func NewClientWithOptions(opts ...Option) (*Client, error) {
+	o := defaultOptions()
+	for _, opt := range opts {
+		opt(o)
 	}
 
+	httpClient := o.httpClient
 	if httpClient == nil {
 		httpClient = &http.Client{}
 	}
+	wrapped := wrapHTTPClient(httpClient, o, nil)
+	endpoints := resolveEndpoints(wrapped, o)
+	if len(o.rateLimits) > 0 {
+		// Rate limits apply to the final base URIs of the services
+		wrapped = wrapHTTPClient(httpClient, o, resolveRateLimits(o.rateLimits, endpoints))
+	}
+	httpClient = wrapped
+
+	client := &Client{
+		httpClient: httpClient,
+		endpoints:  endpoints,
+		pollPolicy: o.pollPolicy,
+	}
 
-	authManager, err := auth.NewIAMTokenManager(oauthToken, httpClient)
-	if err != nil {
+	if err := client.initCredentials(o); err != nil {
 		return nil, err
 	}
 
-	return &Client{
-		httpClient:  httpClient,
-		authManager: authManager,
-	}, nil
+	return client, nil
+}
+
+// tokenEndpointSetter is implemented by credentials that exchange tokens at the IAM token endpoint
+type tokenEndpointSetter interface {
+	SetTokenEndpoint(endpoint string)
+}
+
+// diskCacheSetter is implemented by credentials that can share tokens through a disk cache
+type diskCacheSetter interface {
+	SetDiskCache(cache *auth.DiskTokenCache)
+}
+
+// initCredentials creates credentials from options, using the client HTTP client and IAM endpoint
+func (c *Client) initCredentials(o *options) error {
+	tokenEndpoint := c.endpoints[ServiceIAM] + "iam/v1/tokens"
+
+	switch {
+	case o.credentials != nil:
+		c.credentials = o.credentials
+
+	case o.serviceAccountKey != nil:
+		credentials, err := auth.NewServiceAccountKeyCredentials(o.serviceAccountKey, c.httpClient)
+		if err != nil {
+			return err
+		}
+		credentials.SetTokenEndpoint(tokenEndpoint)
+		c.credentials = credentials
+
+	case o.wifSubjectToken != nil:
+		credentials, err := auth.NewWorkloadIdentityCredentials(o.wifServiceAccountID, o.wifSubjectToken, c.httpClient)
+		if err != nil {
+			return err
+		}
+		if o.wifSTSEndpoint != "" {
+			credentials.SetSTSEndpoint(o.wifSTSEndpoint)
+		}
+		c.credentials = credentials
+
+	case o.defaultCredentials:
+		credentials, err := auth.DefaultCredentials(c.httpClient)
+		if err != nil {
+			return err
+		}
+		if setter, ok := credentials.(tokenEndpointSetter); ok {
+			setter.SetTokenEndpoint(tokenEndpoint)
+		}
+		if authManager, ok := credentials.(*auth.IAMTokenManager); ok {
+			c.authManager = authManager
+		}
+		c.credentials = credentials
+
+	case o.metadataAddress != "":
+		credentials := auth.NewMetadataCredentials(c.httpClient)
+		credentials.SetAddress(o.metadataAddress)
+		c.credentials = credentials
+
+	case o.oauthToken != "":
+		authManager, err := auth.NewIAMTokenManager(o.oauthToken, c.httpClient)
+		if err != nil {
+			return err
+		}
+		authManager.SetTokenEndpoint(tokenEndpoint)
+		c.credentials = authManager
+		c.authManager = authManager
+
+	default:
+		return errors.NewAuthenticationError("OAuth token cannot be empty", nil)
+	}
+
+	if o.diskTokenCache {
+		if setter, ok := c.credentials.(diskCacheSetter); ok {
+			diskCache, err := auth.NewDiskTokenCache(o.diskTokenCacheDir)
+			if err != nil {
+				return err
+			}
+			setter.SetDiskCache(diskCache)
+		}
+	}
+
+	return nil
 }
 
-// Organizations returns the organization resource
-func (c *Client) Organizations() *resources.OrganizationResource {
-	return resources.NewOrganizationResource(c.httpClient, c.authManager, organizationBaseURI)
+// Organizations returns the organization API
+func (c *Client) Organizations() resources.OrganizationsAPI {
+	return resources.NewOrganizationResource(c.httpClient, c.credentials, c.endpoints[ServiceOrganizationManager])
 }
 
-// Clouds returns the cloud resource
-func (c *Client) Clouds() *resources.CloudResource {
-	return resources.NewCloudResource(c.httpClient, c.authManager, resourceManagerBaseURI)
+// Clouds returns the cloud API
+func (c *Client) Clouds() resources.CloudsAPI {
+	return resources.NewCloudResource(c.httpClient, c.credentials, c.endpoints[ServiceResourceManager])
 }
 
-// Folders returns the folder resource
-func (c *Client) Folders() *resources.FolderResource {
-	return resources.NewFolderResource(c.httpClient, c.authManager, resourceManagerBaseURI)
+// Folders returns the folder API
+func (c *Client) Folders() resources.FoldersAPI {
+	return resources.NewFolderResource(c.httpClient, c.credentials, c.endpoints[ServiceResourceManager])
 }
 
-// RefreshTokens returns the refresh token resource
-func (c *Client) RefreshTokens() *resources.RefreshTokenResource {
-	return resources.NewRefreshTokenResource(c.httpClient, c.authManager, iamBaseURI)
+// RefreshTokens returns the refresh token API
+func (c *Client) RefreshTokens() resources.RefreshTokensAPI {
+	return resources.NewRefreshTokenResource(c.httpClient, c.credentials, c.endpoints[ServiceIAM])
 }
 
-// ServiceAccounts returns the service account resource
-func (c *Client) ServiceAccounts() *resources.ServiceAccountResource {
-	return resources.NewServiceAccountResource(c.httpClient, c.authManager, iamBaseURI)
+// ServiceAccounts returns the service account API
+func (c *Client) ServiceAccounts() resources.ServiceAccountsAPI {
+	return resources.NewServiceAccountResource(c.httpClient, c.credentials, c.endpoints[ServiceIAM])
 }
 
-// UserAccounts returns the user account resource
-func (c *Client) UserAccounts() *resources.UserAccountResource {
-	return resources.NewUserAccountResource(c.httpClient, c.authManager, iamBaseURI)
+// UserAccounts returns the user account API
+func (c *Client) UserAccounts() resources.UserAccountsAPI {
+	return resources.NewUserAccountResource(c.httpClient, c.credentials, c.endpoints[ServiceIAM])
 }
 
-// YandexPassportUserAccounts returns the Yandex Passport user account resource
-func (c *Client) YandexPassportUserAccounts() *resources.YandexPassportUserAccountResource {
-	return resources.NewYandexPassportUserAccountResource(c.httpClient, c.authManager, iamBaseURI)
+// YandexPassportUserAccounts returns the Yandex Passport user account API
+func (c *Client) YandexPassportUserAccounts() resources.YandexPassportUserAccountsAPI {
+	return resources.NewYandexPassportUserAccountResource(c.httpClient, c.credentials, c.endpoints[ServiceIAM])
 }
 
-// APIKeys returns the API key resource
-func (c *Client) APIKeys() *resources.APIKeyResource {
-	return resources.NewAPIKeyResource(c.httpClient, c.authManager, iamBaseURI)
+// APIKeys returns the API key API
+func (c *Client) APIKeys() resources.APIKeysAPI {
+	return resources.NewAPIKeyResource(c.httpClient, c.credentials, c.endpoints[ServiceIAM])
 }
 
-// GetHTTPClient returns the HTTP client
+// Operations returns the operation API
+func (c *Client) Operations() resources.OperationsAPI {
+	operations := resources.NewOperationResource(c.httpClient, c.credentials, c.endpoints[ServiceOperation])
+	operations.SetPollPolicy(c.pollPolicy)
+	return operations
+}
+
+// GetHTTPClient returns the HTTP client used for all requests: a copy of the client passed to
+// WithHTTPClient with the client transports (retries, middlewares, logging, ...) and WithTimeout applied
 func (c *Client) GetHTTPClient() *http.Client {
 	return c.httpClient
 }
 
-// GetAuthManager returns the authentication manager
+// GetCredentials returns the credentials used to authenticate requests
+func (c *Client) GetCredentials() auth.Credentials {
+	return c.credentials
+}
+
+// GetAuthManager returns the authentication manager (nil if the client uses other credentials)
 func (c *Client) GetAuthManager() *auth.IAMTokenManager {
 	return c.authManager
 }
 
-// GetOAuthToken returns the OAuth token
+// GetOAuthToken returns the OAuth token (empty if the client uses other credentials)
 func (c *Client) GetOAuthToken() string {
+	if c.authManager == nil {
+		return ""
+	}
 	return c.authManager.GetOAuthToken()
 }
+
+// Endpoint returns the base URI used for the service (empty if the service is unknown)
+func (c *Client) Endpoint(service Service) string {
+	return c.endpoints[service]
+}
+
+// wrapHTTPClient returns a copy of httpClient with the configured transports and rateLimits by host installed
+func wrapHTTPClient(httpClient *http.Client, o *options, rateLimits map[string]transport.RateLimit) *http.Client {
+	rt := httpClient.Transport
+	if o.logger != nil {
+		rt = transport.NewLoggingTransport(rt, o.logger, o.logOptions)
+	}
+	if len(rateLimits) > 0 {
+		rt = transport.NewRateLimitTransport(rt, rateLimits)
+	}
+	if o.retryPolicy != nil {
+		rt = transport.NewRetryTransport(rt, *o.retryPolicy)
+	}
+	if len(o.middlewares) > 0 {
+		rt = transport.NewMiddlewareTransport(rt, o.middlewares...)
+	}
+	rt = transport.NewRequestIDTransport(rt)
+	rt = transport.NewUserAgentTransport(rt, o.userAgentSuffix)
+
+	wrapped := *httpClient
+	wrapped.Transport = rt
+	if o.timeout > 0 {
+		wrapped.Timeout = o.timeout
+	}
+
+	return &wrapped
+}
@@ -0,0 +1,221 @@
+package yandexcloud_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	yandexcloud "github.com/tigusigalpa/yandex-cloud-client-go"
+	"github.com/tigusigalpa/yandex-cloud-client-go/models"
+	"github.com/tigusigalpa/yandex-cloud-client-go/transport"
+	"github.com/tigusigalpa/yandex-cloud-client-go/yandexcloudtest"
+)
+
+// A proactive token refresh must not inherit values of the request that triggered it
+func TestProactiveRefreshDoesNotShareRequestContext(t *testing.T) {
+	var (
+		mu          sync.Mutex
+		exchangeIDs []string
+	)
+	recordExchanges := func(next transport.Handler) transport.Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			if info, ok := transport.CallInfoFromContext(req.Context()); ok && info.TokenExchange {
+				mu.Lock()
+				exchangeIDs = append(exchangeIDs, req.Header.Get(transport.ClientRequestIDHeader))
+				mu.Unlock()
+			}
+			return next(req)
+		}
+	}
+
+	client, server := yandexcloudtest.NewClient(t, yandexcloud.WithMiddleware(recordExchanges))
+	server.TokenTTL = 2 * time.Second
+	folder := server.AddFolder(models.Folder{Name: "folder"})
+
+	ctx := context.Background()
+	if _, err := client.Folders().Get(ctx, folder.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	// Past the halfway refresh point of the short-lived token
+	time.Sleep(1100 * time.Millisecond)
+
+	var md transport.ResponseMetadata
+	callerCtx := transport.WithResponseMetadata(transport.WithClientRequestID(ctx, "caller-request"), &md)
+	if _, err := client.Folders().Get(callerCtx, folder.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for server.TokenRequests() < 2 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if n := server.TokenRequests(); n != 2 {
+		t.Fatalf("token requests = %d, want 2 (proactive refresh)", n)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	for _, id := range exchangeIDs {
+		if id == "caller-request" {
+			t.Fatal("token exchange carries the client request ID of the triggering call")
+		}
+	}
+	if md.ClientRequestID != "caller-request" || md.StatusCode != http.StatusOK {
+		t.Fatalf("response metadata = %+v", md)
+	}
+}
+
+// The idempotency key must be sent, and visible to middlewares, without a retry policy
+func TestIdempotencyKeyWithoutRetryPolicy(t *testing.T) {
+	var (
+		mu   sync.Mutex
+		keys []string
+	)
+	recordKeys := func(next transport.Handler) transport.Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			if info, ok := transport.CallInfoFromContext(req.Context()); ok && !info.TokenExchange {
+				mu.Lock()
+				keys = append(keys, req.Header.Get(transport.IdempotencyKeyHeader))
+				mu.Unlock()
+			}
+			return next(req)
+		}
+	}
+
+	client, server := yandexcloudtest.NewClient(t, yandexcloud.WithMiddleware(recordKeys))
+	cloud := server.AddCloud(models.Cloud{Name: "cloud"})
+
+	ctx := transport.WithIdempotencyKey(context.Background(), "create-folder-42")
+	if _, err := client.Folders().Create(ctx, cloud.ID, "folder", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(keys) != 1 || keys[0] != "create-folder-42" {
+		t.Fatalf("Idempotency-Key headers = %q, want [create-folder-42]", keys)
+	}
+}
+
+// IAM token exchange passes through the middleware chain marked by its call info
+func TestMiddlewareSeesTokenExchange(t *testing.T) {
+	var calls []transport.CallInfo
+	recordCalls := func(next transport.Handler) transport.Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			info, _ := transport.CallInfoFromContext(req.Context())
+			calls = append(calls, info)
+			return next(req)
+		}
+	}
+
+	client, server := yandexcloudtest.NewClient(t, yandexcloud.WithMiddleware(recordCalls))
+	folder := server.AddFolder(models.Folder{Name: "folder"})
+
+	if _, err := client.Folders().Get(context.Background(), folder.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []transport.CallInfo{
+		{Service: "iam", Resource: "IAMTokens", Method: "Create", TokenExchange: true},
+		{Service: "resourcemanager", Resource: "Folders", Method: "Get"},
+	}
+	if len(calls) != len(want) {
+		t.Fatalf("calls = %+v, want %+v", calls, want)
+	}
+	for i := range want {
+		if calls[i] != want[i] {
+			t.Errorf("call %d = %+v, want %+v", i+1, calls[i], want[i])
+		}
+	}
+}
+
+// WithSTSEndpoint moves workload identity token exchange to another endpoint
+func TestWorkloadIdentityWithSTSEndpoint(t *testing.T) {
+	var subjectTokens []string
+	sts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		subjectTokens = append(subjectTokens, r.PostFormValue("subject_token"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"t1.federated","expires_in":3600}`))
+	}))
+	defer sts.Close()
+
+	var authorization []string
+	recordAuthorization := func(next transport.Handler) transport.Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			if info, ok := transport.CallInfoFromContext(req.Context()); ok && !info.TokenExchange {
+				authorization = append(authorization, req.Header.Get("Authorization"))
+			}
+			return next(req)
+		}
+	}
+
+	client, server := yandexcloudtest.NewClient(t,
+		yandexcloud.WithWorkloadIdentity("ajefederated", func(ctx context.Context) (string, error) {
+			return "oidc-token", nil
+		}),
+		yandexcloud.WithSTSEndpoint(sts.URL),
+		yandexcloud.WithMiddleware(recordAuthorization),
+	)
+	folder := server.AddFolder(models.Folder{Name: "folder"})
+
+	if _, err := client.Folders().Get(context.Background(), folder.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(subjectTokens) != 1 || subjectTokens[0] != "oidc-token" {
+		t.Errorf("exchanged subject tokens = %q, want [oidc-token]", subjectTokens)
+	}
+	if len(authorization) != 1 || authorization[0] != "Bearer t1.federated" {
+		t.Errorf("Authorization headers = %q, want the federated IAM token", authorization)
+	}
+	if n := server.TokenRequests(); n != 0 {
+		t.Errorf("IAM token requests = %d, want 0", n)
+	}
+}
+
+// The client works on a copy of the HTTP client and leaves the original untouched
+func TestHTTPClientIsCopied(t *testing.T) {
+	httpClient := &http.Client{}
+	client, err := yandexcloud.NewClientWithOptions(
+		yandexcloud.WithOAuthToken(yandexcloudtest.OAuthToken),
+		yandexcloud.WithHTTPClient(httpClient),
+		yandexcloud.WithTimeout(30*time.Second),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wrapped := client.GetHTTPClient()
+	if wrapped == httpClient {
+		t.Fatal("GetHTTPClient returned the client passed to WithHTTPClient")
+	}
+	if wrapped.Timeout != 30*time.Second || wrapped.Transport == nil {
+		t.Errorf("wrapped client timeout = %v, transport = %v", wrapped.Timeout, wrapped.Transport)
+	}
+	if httpClient.Timeout != 0 || httpClient.Transport != nil {
+		t.Errorf("original client modified: timeout = %v, transport = %v", httpClient.Timeout, httpClient.Transport)
+	}
+}
+
+// Rate limits follow the service to the base URI set with WithEndpoint
+func TestRateLimitFollowsEndpoint(t *testing.T) {
+	client, server := yandexcloudtest.NewClient(t,
+		yandexcloud.WithRateLimit(yandexcloud.ServiceResourceManager, transport.RateLimit{RequestsPerSecond: 10, Burst: 1}),
+	)
+	folder := server.AddFolder(models.Folder{Name: "folder"})
+
+	// The fake server hosts all services, so token exchange shares the limiter: 4 requests take at least 300ms
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, err := client.Folders().Get(context.Background(), folder.ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 250*time.Millisecond {
+		t.Fatalf("4 requests took %v, want the resource-manager limit applied at the fake server", elapsed)
+	}
+}
@@ -0,0 +1,227 @@
+package yandexcloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tigusigalpa/yandex-cloud-client-go/errors"
+	"github.com/tigusigalpa/yandex-cloud-client-go/transport"
+)
+
+// Service identifies a Yandex Cloud service.
+// Values match service IDs of the Yandex Cloud API endpoints list.
+type Service string
+
+const (
+	ServiceIAM                 Service = "iam"
+	ServiceResourceManager     Service = "resource-manager"
+	ServiceOrganizationManager Service = "organizationmanager"
+	ServiceOperation           Service = "operation"
+)
+
+// defaultEndpoints returns base URIs of the public Yandex Cloud installation
+func defaultEndpoints() map[Service]string {
+	return map[Service]string{
+		ServiceIAM:                 IAMBaseURI,
+		ServiceResourceManager:     ResourceManagerBaseURI,
+		ServiceOrganizationManager: OrganizationBaseURI,
+		ServiceOperation:           OperationBaseURI,
+	}
+}
+
+// normalizeBaseURI makes sure base URI ends with a slash
+func normalizeBaseURI(baseURI string) string {
+	if !strings.HasSuffix(baseURI, "/") {
+		return baseURI + "/"
+	}
+	return baseURI
+}
+
+// DefaultEndpointsURL is the URL of the Yandex Cloud API endpoints list
+const DefaultEndpointsURL = "https://api.cloud.yandex.net/endpoints"
+
+const (
+	// endpointDiscoveryTimeout bounds a single fetch of the endpoints list
+	endpointDiscoveryTimeout = 10 * time.Second
+	// endpointDiscoveryRetryInterval is how long a failed fetch is cached before the list is fetched again
+	endpointDiscoveryRetryInterval = time.Minute
+)
+
+// endpointsEntry is a cached (or in-flight) fetch of an endpoints list
+type endpointsEntry struct {
+	done      chan struct{}
+	endpoints map[Service]string
+	err       error
+	failedAt  time.Time
+}
+
+// endpointsCache caches discovered endpoint lists by endpoints list URL
+var endpointsCache = struct {
+	sync.Mutex
+	entries map[string]*endpointsEntry
+}{
+	entries: make(map[string]*endpointsEntry),
+}
+
+// discoverEndpoints returns base URIs by service from the endpoints list.
+// The list is fetched once per URL per process; concurrent callers share one fetch and
+// failures are cached for endpointDiscoveryRetryInterval. ctx only bounds the wait for the result.
+func discoverEndpoints(ctx context.Context, httpClient *http.Client, endpointsURL string) (map[Service]string, error) {
+	endpointsCache.Lock()
+	entry, ok := endpointsCache.entries[endpointsURL]
+	if ok && entry.err != nil && time.Since(entry.failedAt) >= endpointDiscoveryRetryInterval {
+		ok = false
+	}
+	if !ok {
+		entry = &endpointsEntry{
+			done: make(chan struct{}),
+		}
+		endpointsCache.entries[endpointsURL] = entry
+
+		go entry.fetch(httpClient, endpointsURL)
+	}
+	endpointsCache.Unlock()
+
+	select {
+	case <-entry.done:
+		return entry.endpoints, entry.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// fetch fetches the endpoints list and completes the entry.
+// The fetch is shared, so it is not cancelled together with the caller that started it.
+func (e *endpointsEntry) fetch(httpClient *http.Client, endpointsURL string) {
+	ctx, cancel := context.WithTimeout(context.Background(), endpointDiscoveryTimeout)
+	defer cancel()
+
+	endpoints, err := fetchEndpoints(ctx, httpClient, endpointsURL)
+
+	endpointsCache.Lock()
+	e.endpoints = endpoints
+	e.err = err
+	if err != nil {
+		e.failedAt = time.Now()
+	}
+	endpointsCache.Unlock()
+
+	close(e.done)
+}
+
+// fetchEndpoints fetches the endpoints list and returns base URIs by service
+func fetchEndpoints(ctx context.Context, httpClient *http.Client, endpointsURL string) (map[Service]string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", endpointsURL, nil)
+	if err != nil {
+		return nil, errors.NewAPIError("Failed to create request", 0, err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, errors.NewAPIError("Failed to fetch endpoints list", 0, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.NewAPIError(
+			fmt.Sprintf("Failed to fetch endpoints list (HTTP %d)", resp.StatusCode),
+			resp.StatusCode,
+			nil,
+		)
+	}
+
+	var list struct {
+		Endpoints []struct {
+			ID      string `json:"id"`
+			Address string `json:"address"`
+		} `json:"endpoints"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		return nil, errors.NewAPIError("Failed to parse endpoints list", resp.StatusCode, err)
+	}
+
+	endpoints := make(map[Service]string, len(list.Endpoints))
+	for _, endpoint := range list.Endpoints {
+		if endpoint.ID != "" && endpoint.Address != "" {
+			endpoints[Service(endpoint.ID)] = baseURIFromAddress(endpoint.Address)
+		}
+	}
+
+	return endpoints, nil
+}
+
+// baseURIFromAddress converts an endpoint address (host:port) to an HTTPS base URI
+func baseURIFromAddress(address string) string {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil || port == "443" {
+		host = strings.TrimSuffix(address, ":443")
+		return "https://" + host + "/"
+	}
+	return "https://" + net.JoinHostPort(host, port) + "/"
+}
+
+// resolveEndpoints builds the endpoint table: static defaults, then discovered endpoints, then explicit overrides
+func resolveEndpoints(httpClient *http.Client, o *options) map[Service]string {
+	endpoints := defaultEndpoints()
+
+	if o.endpointsURL != "" {
+		ctx, cancel := context.WithTimeout(o.discoveryContext, endpointDiscoveryTimeout)
+		defer cancel()
+
+		// Fall back to the static table if the endpoints list is unavailable
+		if discovered, err := discoverEndpoints(ctx, httpClient, o.endpointsURL); err == nil {
+			for service, baseURI := range discovered {
+				endpoints[service] = baseURI
+			}
+		}
+	}
+
+	for service, baseURI := range o.endpoints {
+		endpoints[service] = baseURI
+	}
+
+	return endpoints
+}
+
+// resolveRateLimits keys rate limits of services by the hosts of their base URIs in endpoints.
+// Services without a known base URI are skipped; services sharing a host get the strictest limit.
+func resolveRateLimits(limits map[Service]transport.RateLimit, endpoints map[Service]string) map[string]transport.RateLimit {
+	byHost := make(map[string]transport.RateLimit, len(limits))
+	for service, limit := range limits {
+		baseURI, ok := endpoints[service]
+		if !ok {
+			continue
+		}
+		u, err := url.Parse(baseURI)
+		if err != nil || u.Host == "" {
+			continue
+		}
+
+		if current, ok := byHost[u.Host]; ok && !stricterRateLimit(limit, current) {
+			continue
+		}
+		byHost[u.Host] = limit
+	}
+	return byHost
+}
+
+// stricterRateLimit reports whether limit a allows fewer requests than limit b
+func stricterRateLimit(a, b transport.RateLimit) bool {
+	switch {
+	case a.RequestsPerSecond <= 0:
+		return false
+	case b.RequestsPerSecond <= 0:
+		return true
+	case a.RequestsPerSecond != b.RequestsPerSecond:
+		return a.RequestsPerSecond < b.RequestsPerSecond
+	}
+	return a.Burst < b.Burst
+}
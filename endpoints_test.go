@@ -0,0 +1,138 @@
+package yandexcloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/tigusigalpa/yandex-cloud-client-go/transport"
+)
+
+// newEndpointsServer serves an endpoints list, counting requests
+func newEndpointsServer(t *testing.T, handler http.HandlerFunc) (*httptest.Server, *atomic.Int32) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func TestEndpointDiscoverySharesOneFetch(t *testing.T) {
+	server, requests := newEndpointsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(20 * time.Millisecond)
+		w.Write([]byte(`{"endpoints":[{"id":"iam","address":"iam.example.net:443"},{"id":"custom","address":"custom.example.net:8443"}]}`))
+	})
+
+	var wg sync.WaitGroup
+	clients := make([]*Client, 8)
+	for i := range clients {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			client, err := NewClientWithOptions(WithOAuthToken("y0_test"), WithEndpointDiscoveryURL(server.URL))
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			clients[i] = client
+		}(i)
+	}
+	wg.Wait()
+
+	if n := requests.Load(); n != 1 {
+		t.Fatalf("endpoints list fetched %d times, want 1", n)
+	}
+	for _, client := range clients {
+		if client.Endpoint(ServiceIAM) != "https://iam.example.net/" || client.Endpoint("custom") != "https://custom.example.net:8443/" {
+			t.Fatalf("endpoints = %v", client.endpoints)
+		}
+		if client.Endpoint(ServiceOperation) != OperationBaseURI {
+			t.Fatalf("operation endpoint = %q, want static default", client.Endpoint(ServiceOperation))
+		}
+	}
+}
+
+func TestEndpointDiscoveryCachesFailures(t *testing.T) {
+	server, requests := newEndpointsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	for i := 0; i < 3; i++ {
+		client, err := NewClientWithOptions(WithOAuthToken("y0_test"), WithEndpointDiscoveryURL(server.URL))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if client.Endpoint(ServiceIAM) != IAMBaseURI {
+			t.Fatalf("IAM endpoint = %q, want static fallback", client.Endpoint(ServiceIAM))
+		}
+	}
+
+	if n := requests.Load(); n != 1 {
+		t.Fatalf("endpoints list fetched %d times, want 1 (failure cached)", n)
+	}
+}
+
+func TestEndpointDiscoveryRespectsContext(t *testing.T) {
+	release := make(chan struct{})
+	server, _ := newEndpointsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	})
+	defer close(release)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	client, err := NewClientWithOptions(
+		WithOAuthToken("y0_test"),
+		WithEndpointDiscoveryURL(server.URL),
+		WithEndpointDiscoveryContext(ctx),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("client construction took %s, want it bounded by the context", elapsed)
+	}
+	if client.Endpoint(ServiceIAM) != IAMBaseURI {
+		t.Fatalf("IAM endpoint = %q, want static fallback", client.Endpoint(ServiceIAM))
+	}
+}
+
+func TestResolveRateLimits(t *testing.T) {
+	strict := transport.RateLimit{RequestsPerSecond: 5, Burst: 1}
+	loose := transport.RateLimit{RequestsPerSecond: 50, Burst: 10}
+
+	limits := map[Service]transport.RateLimit{
+		ServiceIAM:             loose,
+		ServiceResourceManager: strict,
+		ServiceOperation:       {RequestsPerSecond: 0},
+		"unknown":              strict,
+	}
+	endpoints := map[Service]string{
+		ServiceIAM:             "http://127.0.0.1:8080/",
+		ServiceResourceManager: "http://127.0.0.1:8080/",
+		ServiceOperation:       "https://operation.api.cloud.yandex.net/",
+	}
+
+	got := resolveRateLimits(limits, endpoints)
+	want := map[string]transport.RateLimit{
+		"127.0.0.1:8080":                 strict,
+		"operation.api.cloud.yandex.net": {RequestsPerSecond: 0},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("rate limits = %+v, want %+v", got, want)
+	}
+	for host, limit := range want {
+		if got[host] != limit {
+			t.Errorf("%s: limit = %+v, want %+v", host, got[host], limit)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package errors
+
+import (
+	stderrors "errors"
+	"net/http"
+	"strconv"
+)
+
+// GRPCCode is a gRPC status code returned by Yandex Cloud API
+type GRPCCode int
+
+const (
+	CodeOK                 GRPCCode = 0
+	CodeCanceled           GRPCCode = 1
+	CodeUnknown            GRPCCode = 2
+	CodeInvalidArgument    GRPCCode = 3
+	CodeDeadlineExceeded   GRPCCode = 4
+	CodeNotFound           GRPCCode = 5
+	CodeAlreadyExists      GRPCCode = 6
+	CodePermissionDenied   GRPCCode = 7
+	CodeResourceExhausted  GRPCCode = 8
+	CodeFailedPrecondition GRPCCode = 9
+	CodeAborted            GRPCCode = 10
+	CodeOutOfRange         GRPCCode = 11
+	CodeUnimplemented      GRPCCode = 12
+	CodeInternal           GRPCCode = 13
+	CodeUnavailable        GRPCCode = 14
+	CodeDataLoss           GRPCCode = 15
+	CodeUnauthenticated    GRPCCode = 16
+)
+
+var codeNames = map[GRPCCode]string{
+	CodeOK:                 "OK",
+	CodeCanceled:           "CANCELLED",
+	CodeUnknown:            "UNKNOWN",
+	CodeInvalidArgument:    "INVALID_ARGUMENT",
+	CodeDeadlineExceeded:   "DEADLINE_EXCEEDED",
+	CodeNotFound:           "NOT_FOUND",
+	CodeAlreadyExists:      "ALREADY_EXISTS",
+	CodePermissionDenied:   "PERMISSION_DENIED",
+	CodeResourceExhausted:  "RESOURCE_EXHAUSTED",
+	CodeFailedPrecondition: "FAILED_PRECONDITION",
+	CodeAborted:            "ABORTED",
+	CodeOutOfRange:         "OUT_OF_RANGE",
+	CodeUnimplemented:      "UNIMPLEMENTED",
+	CodeInternal:           "INTERNAL",
+	CodeUnavailable:        "UNAVAILABLE",
+	CodeDataLoss:           "DATA_LOSS",
+	CodeUnauthenticated:    "UNAUTHENTICATED",
+}
+
+// String returns the canonical name of the code (e.g. NOT_FOUND)
+func (c GRPCCode) String() string {
+	if name, ok := codeNames[c]; ok {
+		return name
+	}
+	return "CODE(" + strconv.Itoa(int(c)) + ")"
+}
+
+// codeFromHTTPStatus maps HTTP status to gRPC code for responses without a code
+func codeFromHTTPStatus(statusCode int) GRPCCode {
+	switch statusCode {
+	case http.StatusBadRequest:
+		return CodeInvalidArgument
+	case http.StatusUnauthorized:
+		return CodeUnauthenticated
+	case http.StatusForbidden:
+		return CodePermissionDenied
+	case http.StatusNotFound:
+		return CodeNotFound
+	case http.StatusConflict:
+		return CodeAlreadyExists
+	case http.StatusTooManyRequests:
+		return CodeResourceExhausted
+	case http.StatusNotImplemented:
+		return CodeUnimplemented
+	case http.StatusServiceUnavailable:
+		return CodeUnavailable
+	case http.StatusGatewayTimeout:
+		return CodeDeadlineExceeded
+	case http.StatusInternalServerError:
+		return CodeInternal
+	}
+	return CodeUnknown
+}
+
+// Sentinel errors for errors.Is checks against *APIError and *OperationError
+var (
+	ErrNotFound         = stderrors.New("not found")
+	ErrAlreadyExists    = stderrors.New("already exists")
+	ErrPermissionDenied = stderrors.New("permission denied")
+	ErrUnauthenticated  = stderrors.New("unauthenticated")
+	ErrQuotaExceeded    = stderrors.New("quota exceeded")
+	ErrInvalidArgument  = stderrors.New("invalid argument")
+	ErrUnavailable      = stderrors.New("unavailable")
+)
+
+var sentinelCodes = map[error]GRPCCode{
+	ErrNotFound:         CodeNotFound,
+	ErrAlreadyExists:    CodeAlreadyExists,
+	ErrPermissionDenied: CodePermissionDenied,
+	ErrUnauthenticated:  CodeUnauthenticated,
+	ErrQuotaExceeded:    CodeResourceExhausted,
+	ErrInvalidArgument:  CodeInvalidArgument,
+	ErrUnavailable:      CodeUnavailable,
+}
+
+// matchesCode reports whether target is the sentinel error for code
+func matchesCode(code GRPCCode, target error) bool {
+	sentinelCode, ok := sentinelCodes[target]
+	return ok && sentinelCode == code
+}
+
+// CodeOf returns the gRPC code of an *APIError or *OperationError in err's chain (CodeUnknown otherwise)
+func CodeOf(err error) GRPCCode {
+	var apiErr *APIError
+	if stderrors.As(err, &apiErr) {
+		return apiErr.GRPCCode
+	}
+
+	var operationErr *OperationError
+	if stderrors.As(err, &operationErr) {
+		return operationErr.Code
+	}
+
+	return CodeUnknown
+}
+
+// IsNotFound checks if err is a NOT_FOUND API or operation error
+func IsNotFound(err error) bool {
+	return stderrors.Is(err, ErrNotFound)
+}
+
+// IsAlreadyExists checks if err is an ALREADY_EXISTS API or operation error
+func IsAlreadyExists(err error) bool {
+	return stderrors.Is(err, ErrAlreadyExists)
+}
+
+// IsPermissionDenied checks if err is a PERMISSION_DENIED API or operation error
+func IsPermissionDenied(err error) bool {
+	return stderrors.Is(err, ErrPermissionDenied)
+}
+
+// IsUnauthenticated checks if err is an UNAUTHENTICATED API or operation error
+func IsUnauthenticated(err error) bool {
+	return stderrors.Is(err, ErrUnauthenticated)
+}
+
+// IsQuotaExceeded checks if err is a RESOURCE_EXHAUSTED (quota or rate limit) API or operation error
+func IsQuotaExceeded(err error) bool {
+	return stderrors.Is(err, ErrQuotaExceeded)
+}
@@ -1,6 +1,10 @@
 package errors
 
-import "fmt"
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
 
 // YandexCloudError is the base error type for all Yandex Cloud errors
 type YandexCloudError struct {
@@ -37,18 +41,98 @@ func NewAuthenticationError(message string, err error) *AuthenticationError {
 type APIError struct {
 	YandexCloudError
 	StatusCode int
+	// Code is the canonical name of the gRPC status code (e.g. NOT_FOUND)
+	Code string
+	// GRPCCode is the gRPC status code returned by the API
+	GRPCCode GRPCCode
+	// Details holds error details returned by the API
+	Details []json.RawMessage
+	// RequestID is the Yandex Cloud (server) request ID
+	RequestID string
+	// ClientRequestID is the client request ID sent with the request
+	ClientRequestID string
 }
 
+// NewAPIError creates an API error whose gRPC code is derived from the HTTP status
+// (CodeUnknown for errors without a response, e.g. connection failures)
 func NewAPIError(message string, statusCode int, err error) *APIError {
+	code := codeFromHTTPStatus(statusCode)
 	return &APIError{
 		YandexCloudError: YandexCloudError{
 			Message: message,
 			Err:     err,
 		},
 		StatusCode: statusCode,
+		Code:       code.String(),
+		GRPCCode:   code,
 	}
 }
 
+// NewAPIErrorFromResponse decodes an error response body ({code, message, details}) into an APIError
+func NewAPIErrorFromResponse(statusCode int, body []byte) *APIError {
+	var status struct {
+		Code    *int              `json:"code"`
+		Message string            `json:"message"`
+		Details []json.RawMessage `json:"details"`
+	}
+
+	message := string(body)
+	code := codeFromHTTPStatus(statusCode)
+
+	if err := json.Unmarshal(body, &status); err == nil {
+		if status.Message != "" {
+			message = status.Message
+		}
+		if status.Code != nil {
+			code = GRPCCode(*status.Code)
+		}
+	}
+
+	apiErr := NewAPIError(
+		fmt.Sprintf("API request failed with status %d: %s", statusCode, message),
+		statusCode,
+		nil,
+	)
+	apiErr.Code = code.String()
+	apiErr.GRPCCode = code
+	apiErr.Details = status.Details
+	apiErr.RequestID = requestIDFromDetails(status.Details)
+
+	return apiErr
+}
+
+// NewAPIErrorFromHTTPResponse decodes an error response and attaches request IDs from HTTP headers
+func NewAPIErrorFromHTTPResponse(resp *http.Response, body []byte) *APIError {
+	apiErr := NewAPIErrorFromResponse(resp.StatusCode, body)
+
+	if requestID := resp.Header.Get("X-Request-Id"); requestID != "" {
+		apiErr.RequestID = requestID
+	}
+	if resp.Request != nil {
+		apiErr.ClientRequestID = resp.Request.Header.Get("X-Client-Request-Id")
+	}
+
+	return apiErr
+}
+
+// Is reports whether the error matches a sentinel error such as ErrNotFound
+func (e *APIError) Is(target error) bool {
+	return matchesCode(e.GRPCCode, target)
+}
+
+// requestIDFromDetails extracts request ID from google.rpc.RequestInfo error details
+func requestIDFromDetails(details []json.RawMessage) string {
+	for _, detail := range details {
+		var requestInfo struct {
+			RequestID string `json:"requestId"`
+		}
+		if err := json.Unmarshal(detail, &requestInfo); err == nil && requestInfo.RequestID != "" {
+			return requestInfo.RequestID
+		}
+	}
+	return ""
+}
+
 // ValidationError represents validation errors
 type ValidationError struct {
 	YandexCloudError
@@ -61,3 +145,27 @@ func NewValidationError(message string) *ValidationError {
 		},
 	}
 }
+
+// OperationError represents a long-running operation that finished with an error status
+type OperationError struct {
+	YandexCloudError
+	OperationID string
+	Code        GRPCCode
+	Details     []json.RawMessage
+}
+
+func NewOperationError(operationID, message string, code GRPCCode, details []json.RawMessage) *OperationError {
+	return &OperationError{
+		YandexCloudError: YandexCloudError{
+			Message: fmt.Sprintf("Operation %s failed (%s): %s", operationID, code, message),
+		},
+		OperationID: operationID,
+		Code:        code,
+		Details:     details,
+	}
+}
+
+// Is reports whether the error matches a sentinel error such as ErrNotFound
+func (e *OperationError) Is(target error) bool {
+	return matchesCode(e.Code, target)
+}
@@ -0,0 +1,51 @@
+package errors
+
+import (
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestNewAPIErrorCodeFromStatus(t *testing.T) {
+	tests := []struct {
+		statusCode int
+		want       GRPCCode
+	}{
+		{0, CodeUnknown},
+		{http.StatusOK, CodeUnknown},
+		{http.StatusNotFound, CodeNotFound},
+		{http.StatusServiceUnavailable, CodeUnavailable},
+	}
+
+	for _, tt := range tests {
+		err := NewAPIError("HTTP request failed", tt.statusCode, stderrors.New("connection refused"))
+		if err.GRPCCode != tt.want || err.Code != tt.want.String() {
+			t.Errorf("status %d: got %s (%q), want %s", tt.statusCode, err.GRPCCode, err.Code, tt.want)
+		}
+	}
+}
+
+func TestCodeOfTransportError(t *testing.T) {
+	err := fmt.Errorf("list folders: %w", NewAPIError("HTTP request failed", 0, stderrors.New("connection refused")))
+
+	if code := CodeOf(err); code != CodeUnknown {
+		t.Fatalf("CodeOf = %s, want %s", code, CodeUnknown)
+	}
+}
+
+func TestNewAPIErrorFromResponse(t *testing.T) {
+	body := []byte(`{"code":5,"message":"Folder not found","details":[{"requestId":"req-1"}]}`)
+
+	err := NewAPIErrorFromResponse(http.StatusNotFound, body)
+
+	if err.GRPCCode != CodeNotFound || err.Code != "NOT_FOUND" {
+		t.Errorf("code = %s (%q), want NOT_FOUND", err.GRPCCode, err.Code)
+	}
+	if err.RequestID != "req-1" {
+		t.Errorf("RequestID = %q, want req-1", err.RequestID)
+	}
+	if !stderrors.Is(err, ErrNotFound) {
+		t.Error("errors.Is(err, ErrNotFound) = false")
+	}
+}
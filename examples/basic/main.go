@@ -1,73 +1,72 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
+	"time"
 
 	yandexcloud "github.com/tigusigalpa/yandex-cloud-client-go"
 )
 
 func main() {
-	// Get OAuth token from environment variable
-	oauthToken := os.Getenv("YANDEX_CLOUD_OAUTH_TOKEN")
-	if oauthToken == "" {
-		log.Fatal("YANDEX_CLOUD_OAUTH_TOKEN environment variable is required")
-	}
-
-	// Initialize client
-	client, err := yandexcloud.NewClient(oauthToken, nil)
+	// Initialize client with credentials from YC_TOKEN, YC_IAM_TOKEN,
+	// YC_SERVICE_ACCOUNT_KEY_FILE, the yc CLI profile or the metadata service
+	client, err := yandexcloud.NewClientWithOptions(yandexcloud.WithDefaultCredentials())
 	if err != nil {
 		log.Fatalf("Failed to create client: %v", err)
 	}
 
 	fmt.Println("✅ Client initialized successfully")
 
+	ctx := context.Background()
+
 	// List organizations
 	fmt.Println("\n📋 Listing organizations...")
-	organizations, err := client.Organizations().List(nil, nil)
+	organizations, err := client.Organizations().List(ctx, nil, nil)
 	if err != nil {
 		log.Fatalf("Failed to list organizations: %v", err)
 	}
-	fmt.Printf("Organizations: %+v\n", organizations)
+	for _, org := range organizations.Organizations {
+		fmt.Printf("Organization: %s (%s)\n", org.Name, org.ID)
+	}
 
 	// Get organization ID from environment or use first from list
 	organizationID := os.Getenv("YANDEX_CLOUD_ORGANIZATION_ID")
-	if organizationID == "" && organizations["organizations"] != nil {
-		orgs := organizations["organizations"].([]interface{})
-		if len(orgs) > 0 {
-			org := orgs[0].(map[string]interface{})
-			organizationID = org["id"].(string)
-		}
+	if organizationID == "" && len(organizations.Organizations) > 0 {
+		organizationID = organizations.Organizations[0].ID
 	}
 
 	if organizationID != "" {
 		// List clouds in organization
 		fmt.Printf("\n☁️  Listing clouds in organization %s...\n", organizationID)
-		clouds, err := client.Clouds().List(&organizationID, nil, nil)
+		clouds, err := client.Clouds().List(ctx, &organizationID, nil, nil)
 		if err != nil {
 			log.Fatalf("Failed to list clouds: %v", err)
 		}
-		fmt.Printf("Clouds: %+v\n", clouds)
+		for _, cloud := range clouds.Clouds {
+			fmt.Printf("Cloud: %s (%s)\n", cloud.Name, cloud.ID)
+		}
 
 		// Get cloud ID from environment or use first from list
 		cloudID := os.Getenv("YANDEX_CLOUD_CLOUD_ID")
-		if cloudID == "" && clouds["clouds"] != nil {
-			cloudsList := clouds["clouds"].([]interface{})
-			if len(cloudsList) > 0 {
-				cloud := cloudsList[0].(map[string]interface{})
-				cloudID = cloud["id"].(string)
-			}
+		if cloudID == "" && len(clouds.Clouds) > 0 {
+			cloudID = clouds.Clouds[0].ID
 		}
 
 		if cloudID != "" {
 			// List folders in cloud
 			fmt.Printf("\n📁 Listing folders in cloud %s...\n", cloudID)
-			folders, err := client.Folders().List(cloudID, nil, nil)
-			if err != nil {
+			folders := client.Folders().ListAll(ctx, cloudID, nil)
+			for folders.Next() {
+				folder := folders.Item()
+				fmt.Printf("Folder: %s (%s), status %s, created at %s\n",
+					folder.Name, folder.ID, folder.Status, folder.CreatedAt.Format(time.RFC3339))
+			}
+			if err := folders.Err(); err != nil {
 				log.Fatalf("Failed to list folders: %v", err)
 			}
-			fmt.Printf("Folders: %+v\n", folders)
 		}
 	}
 
@@ -0,0 +1,87 @@
+// Package metrics collects latency and error metrics of Yandex Cloud API calls and IAM token refreshes
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/tigusigalpa/yandex-cloud-client-go/errors"
+	"github.com/tigusigalpa/yandex-cloud-client-go/transport"
+)
+
+// Call describes a completed API call or IAM token exchange
+type Call struct {
+	// Service is the API service, e.g. "resourcemanager" (empty for requests made outside of resources)
+	Service string
+	// Method is the resource method, e.g. "Folders.List" (the HTTP method for requests made outside of resources)
+	Method string
+	// StatusCode is the HTTP status code (0 if no response was received)
+	StatusCode int
+	// Code is the gRPC status code returned by the API (CodeUnknown if no response was received)
+	Code errors.GRPCCode
+	// Duration is the call latency including retries
+	Duration time.Duration
+	// Err is the transport error, if no response was received
+	Err error
+}
+
+// Failed reports whether the call failed
+func (c Call) Failed() bool {
+	return c.Err != nil || c.StatusCode >= 400
+}
+
+// Collector receives metrics, e.g. to export them to Prometheus.
+// Implementations must be safe for concurrent use.
+type Collector interface {
+	// ObserveCall is called after every API call
+	ObserveCall(call Call)
+	// ObserveTokenRefresh is called after every IAM token exchange
+	ObserveTokenRefresh(call Call)
+}
+
+// Middleware returns a client middleware that reports every API call and IAM token exchange to collector
+//
+//	client, err := yandexcloud.NewClientWithOptions(
+//		yandexcloud.WithOAuthToken(oauthToken),
+//		yandexcloud.WithMiddleware(metrics.Middleware(collector)),
+//	)
+func Middleware(collector Collector) transport.Middleware {
+	return func(next transport.Handler) transport.Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			info, _ := transport.CallInfoFromContext(req.Context())
+
+			call := Call{
+				Service: info.Service,
+				Method:  req.Method,
+			}
+			if info.Service != "" {
+				call.Method = info.Resource + "." + info.Method
+			}
+
+			start := time.Now()
+			resp, err := next(req)
+			call.Duration = time.Since(start)
+
+			switch {
+			case err != nil:
+				call.Code = errors.CodeOf(err)
+				call.Err = err
+			case resp.StatusCode >= 400:
+				body, _ := transport.ReadResponseBody(resp)
+				call.StatusCode = resp.StatusCode
+				call.Code = errors.NewAPIErrorFromHTTPResponse(resp, body).GRPCCode
+			default:
+				call.StatusCode = resp.StatusCode
+				call.Code = errors.CodeOK
+			}
+
+			if info.TokenExchange {
+				collector.ObserveTokenRefresh(call)
+			} else {
+				collector.ObserveCall(call)
+			}
+
+			return resp, err
+		}
+	}
+}
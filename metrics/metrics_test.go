@@ -0,0 +1,157 @@
+package metrics_test
+
+import (
+	"context"
+	stderrors "errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	yandexcloud "github.com/tigusigalpa/yandex-cloud-client-go"
+	"github.com/tigusigalpa/yandex-cloud-client-go/errors"
+	"github.com/tigusigalpa/yandex-cloud-client-go/metrics"
+	"github.com/tigusigalpa/yandex-cloud-client-go/models"
+	"github.com/tigusigalpa/yandex-cloud-client-go/transport"
+	"github.com/tigusigalpa/yandex-cloud-client-go/yandexcloudtest"
+)
+
+// fakeCollector records observed calls
+type fakeCollector struct {
+	mu             sync.Mutex
+	calls          []metrics.Call
+	tokenRefreshes []metrics.Call
+}
+
+func (c *fakeCollector) ObserveCall(call metrics.Call) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls = append(c.calls, call)
+}
+
+func (c *fakeCollector) ObserveTokenRefresh(call metrics.Call) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.tokenRefreshes = append(c.tokenRefreshes, call)
+}
+
+// observe sends a request for info through the middleware to a handler returning resp and err
+func observe(t *testing.T, info transport.CallInfo, resp *http.Response, err error) (metrics.Call, *http.Response) {
+	t.Helper()
+
+	collector := &fakeCollector{}
+	handler := metrics.Middleware(collector)(func(req *http.Request) (*http.Response, error) {
+		return resp, err
+	})
+
+	req, reqErr := http.NewRequestWithContext(transport.WithCallInfo(context.Background(), info), "GET", "https://example.com/", nil)
+	if reqErr != nil {
+		t.Fatal(reqErr)
+	}
+	got, _ := handler(req)
+
+	if len(collector.calls) != 1 {
+		t.Fatalf("observed %d calls, want 1", len(collector.calls))
+	}
+	return collector.calls[0], got
+}
+
+// response returns a response with status and a JSON body
+func response(status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func TestMiddlewareRoutesCalls(t *testing.T) {
+	collector := &fakeCollector{}
+	client, server := yandexcloudtest.NewClient(t, yandexcloud.WithMiddleware(metrics.Middleware(collector)))
+	cloud := server.AddCloud(models.Cloud{Name: "cloud"})
+
+	if _, err := client.Folders().List(context.Background(), cloud.ID, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+
+	if len(collector.tokenRefreshes) != 1 || collector.tokenRefreshes[0].Method != "IAMTokens.Create" {
+		t.Fatalf("token refreshes = %+v, want one IAMTokens.Create", collector.tokenRefreshes)
+	}
+	if len(collector.calls) != 1 {
+		t.Fatalf("calls = %+v, want one", collector.calls)
+	}
+
+	call := collector.calls[0]
+	if call.Service != "resourcemanager" || call.Method != "Folders.List" {
+		t.Errorf("call = %s %s, want resourcemanager Folders.List", call.Service, call.Method)
+	}
+	if call.StatusCode != http.StatusOK || call.Code != errors.CodeOK || call.Failed() || call.Duration <= 0 {
+		t.Errorf("call = %+v", call)
+	}
+}
+
+func TestMiddlewareMethodWithoutCallInfo(t *testing.T) {
+	call, _ := observe(t, transport.CallInfo{}, response(http.StatusOK, `{}`), nil)
+
+	if call.Service != "" || call.Method != "GET" {
+		t.Fatalf("call = %s %s, want an empty service and the HTTP method", call.Service, call.Method)
+	}
+}
+
+func TestMiddlewareDecodesErrorBody(t *testing.T) {
+	info := transport.CallInfo{Service: "resourcemanager", Resource: "Folders", Method: "Delete"}
+	body := `{"code":9,"message":"Folder is not empty"}`
+
+	call, resp := observe(t, info, response(http.StatusBadRequest, body), nil)
+
+	// The code comes from the body rather than the HTTP status (400 maps to INVALID_ARGUMENT)
+	if call.StatusCode != http.StatusBadRequest || call.Code != errors.CodeFailedPrecondition {
+		t.Errorf("call status = %d, code = %s; want 400, %s", call.StatusCode, call.Code, errors.CodeFailedPrecondition)
+	}
+	if !call.Failed() || call.Err != nil {
+		t.Errorf("Failed() = %v, Err = %v; want a failure without a transport error", call.Failed(), call.Err)
+	}
+
+	// The body stays readable for the caller
+	data, err := io.ReadAll(resp.Body)
+	if err != nil || string(data) != body {
+		t.Errorf("response body = %q, %v; want %q", data, err, body)
+	}
+}
+
+func TestMiddlewareTransportError(t *testing.T) {
+	info := transport.CallInfo{Service: "iam", Resource: "ServiceAccounts", Method: "Get"}
+	transportErr := stderrors.New("connection reset")
+
+	call, _ := observe(t, info, nil, transportErr)
+
+	if call.Err != transportErr || !call.Failed() {
+		t.Errorf("Err = %v, Failed() = %v; want the transport error", call.Err, call.Failed())
+	}
+	if call.StatusCode != 0 || call.Code != errors.CodeUnknown {
+		t.Errorf("status = %d, code = %s; want 0, %s", call.StatusCode, call.Code, errors.CodeUnknown)
+	}
+}
+
+func TestCallFailed(t *testing.T) {
+	tests := []struct {
+		call metrics.Call
+		want bool
+	}{
+		{metrics.Call{StatusCode: http.StatusOK}, false},
+		{metrics.Call{StatusCode: http.StatusNoContent}, false},
+		{metrics.Call{StatusCode: http.StatusNotFound}, true},
+		{metrics.Call{StatusCode: http.StatusServiceUnavailable}, true},
+		{metrics.Call{Err: stderrors.New("timeout")}, true},
+	}
+
+	for _, test := range tests {
+		if got := test.call.Failed(); got != test.want {
+			t.Errorf("%+v: Failed() = %v, want %v", test.call, got, test.want)
+		}
+	}
+}
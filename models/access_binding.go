@@ -0,0 +1,19 @@
+package models
+
+// AccessBinding binds a role to a subject
+type AccessBinding struct {
+	RoleID  string  `json:"roleId"`
+	Subject Subject `json:"subject"`
+}
+
+// Subject is the subject of an access binding
+type Subject struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// ListAccessBindingsResponse is the response of the list access bindings request
+type ListAccessBindingsResponse struct {
+	AccessBindings []AccessBinding `json:"accessBindings"`
+	NextPageToken  string          `json:"nextPageToken,omitempty"`
+}
@@ -0,0 +1,27 @@
+package models
+
+import "time"
+
+// APIKey represents an IAM API key of a service account
+type APIKey struct {
+	ID               string     `json:"id"`
+	ServiceAccountID string     `json:"serviceAccountId"`
+	CreatedAt        time.Time  `json:"createdAt"`
+	Description      string     `json:"description,omitempty"`
+	LastUsedAt       *time.Time `json:"lastUsedAt,omitempty"`
+	Scope            string     `json:"scope,omitempty"`
+	ExpiresAt        *time.Time `json:"expiresAt,omitempty"`
+}
+
+// CreateAPIKeyResponse is the response of the create API key request.
+// Secret is returned only once, on creation.
+type CreateAPIKeyResponse struct {
+	APIKey APIKey `json:"apiKey"`
+	Secret string `json:"secret"`
+}
+
+// ListAPIKeysResponse is the response of the list API keys request
+type ListAPIKeysResponse struct {
+	APIKeys       []APIKey `json:"apiKeys"`
+	NextPageToken string   `json:"nextPageToken,omitempty"`
+}
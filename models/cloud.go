@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// Cloud represents a Yandex Cloud cloud
+type Cloud struct {
+	ID             string            `json:"id"`
+	CreatedAt      time.Time         `json:"createdAt"`
+	Name           string            `json:"name"`
+	Description    string            `json:"description,omitempty"`
+	OrganizationID string            `json:"organizationId,omitempty"`
+	Labels         map[string]string `json:"labels,omitempty"`
+}
+
+// ListCloudsResponse is the response of the list clouds request
+type ListCloudsResponse struct {
+	Clouds        []Cloud `json:"clouds"`
+	NextPageToken string  `json:"nextPageToken,omitempty"`
+}
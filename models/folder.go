@@ -0,0 +1,30 @@
+package models
+
+import "time"
+
+// FolderStatus is the status of a folder
+type FolderStatus string
+
+const (
+	FolderStatusUnspecified     FolderStatus = "STATUS_UNSPECIFIED"
+	FolderStatusActive          FolderStatus = "ACTIVE"
+	FolderStatusDeleting        FolderStatus = "DELETING"
+	FolderStatusPendingDeletion FolderStatus = "PENDING_DELETION"
+)
+
+// Folder represents a Yandex Cloud folder
+type Folder struct {
+	ID          string            `json:"id"`
+	CloudID     string            `json:"cloudId"`
+	CreatedAt   time.Time         `json:"createdAt"`
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Status      FolderStatus      `json:"status,omitempty"`
+}
+
+// ListFoldersResponse is the response of the list folders request
+type ListFoldersResponse struct {
+	Folders       []Folder `json:"folders"`
+	NextPageToken string   `json:"nextPageToken,omitempty"`
+}
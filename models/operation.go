@@ -0,0 +1,32 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Operation represents a long-running Yandex Cloud operation
+type Operation struct {
+	ID          string          `json:"id"`
+	Description string          `json:"description,omitempty"`
+	CreatedAt   time.Time       `json:"createdAt"`
+	CreatedBy   string          `json:"createdBy,omitempty"`
+	ModifiedAt  time.Time       `json:"modifiedAt"`
+	Done        bool            `json:"done"`
+	Metadata    json.RawMessage `json:"metadata,omitempty"`
+	Error       *Status         `json:"error,omitempty"`
+	Response    json.RawMessage `json:"response,omitempty"`
+}
+
+// Status is the error status of a failed operation (google.rpc.Status)
+type Status struct {
+	Code    int               `json:"code"`
+	Message string            `json:"message"`
+	Details []json.RawMessage `json:"details,omitempty"`
+}
+
+// ListOperationsResponse is the response of the list operations request
+type ListOperationsResponse struct {
+	Operations    []Operation `json:"operations"`
+	NextPageToken string      `json:"nextPageToken,omitempty"`
+}
@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// Organization represents an organization
+type Organization struct {
+	ID          string            `json:"id"`
+	CreatedAt   time.Time         `json:"createdAt"`
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Title       string            `json:"title,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+}
+
+// ListOrganizationsResponse is the response of the list organizations request
+type ListOrganizationsResponse struct {
+	Organizations []Organization `json:"organizations"`
+	NextPageToken string         `json:"nextPageToken,omitempty"`
+}
@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+// RefreshToken represents an IAM refresh token
+type RefreshToken struct {
+	ID                 string     `json:"id"`
+	SubjectID          string     `json:"subjectId"`
+	ClientID           string     `json:"clientId,omitempty"`
+	ClientInstanceInfo string     `json:"clientInstanceInfo,omitempty"`
+	ProtectionLevel    string     `json:"protectionLevel,omitempty"`
+	CreatedAt          time.Time  `json:"createdAt"`
+	ExpiresAt          *time.Time `json:"expiresAt,omitempty"`
+	LastUsedAt         *time.Time `json:"lastUsedAt,omitempty"`
+}
+
+// ListRefreshTokensResponse is the response of the list refresh tokens request
+type ListRefreshTokensResponse struct {
+	RefreshTokens []RefreshToken `json:"refreshTokens"`
+	NextPageToken string         `json:"nextPageToken,omitempty"`
+}
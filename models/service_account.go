@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// ServiceAccount represents an IAM service account
+type ServiceAccount struct {
+	ID                  string            `json:"id"`
+	FolderID            string            `json:"folderId"`
+	CreatedAt           time.Time         `json:"createdAt"`
+	Name                string            `json:"name"`
+	Description         string            `json:"description,omitempty"`
+	Labels              map[string]string `json:"labels,omitempty"`
+	LastAuthenticatedAt *time.Time        `json:"lastAuthenticatedAt,omitempty"`
+}
+
+// ListServiceAccountsResponse is the response of the list service accounts request
+type ListServiceAccountsResponse struct {
+	ServiceAccounts []ServiceAccount `json:"serviceAccounts"`
+	NextPageToken   string           `json:"nextPageToken,omitempty"`
+}
@@ -0,0 +1,37 @@
+package models
+
+// UserAccount represents a user account.
+// Exactly one of YandexPassportUserAccount and SAMLUserAccount is set.
+type UserAccount struct {
+	ID                        string                     `json:"id"`
+	YandexPassportUserAccount *YandexPassportUserAccount `json:"yandexPassportUserAccount,omitempty"`
+	SAMLUserAccount           *SAMLUserAccount           `json:"samlUserAccount,omitempty"`
+}
+
+// YandexPassportUserAccount is a Yandex Passport account of a user
+type YandexPassportUserAccount struct {
+	Login        string `json:"login"`
+	DefaultEmail string `json:"defaultEmail,omitempty"`
+}
+
+// SAMLUserAccount is a federated (SAML) account of a user
+type SAMLUserAccount struct {
+	FederationID string                   `json:"federationId"`
+	NameID       string                   `json:"nameId"`
+	Attributes   map[string]SAMLAttribute `json:"attributes,omitempty"`
+}
+
+// SAMLAttribute holds values of a SAML attribute
+type SAMLAttribute struct {
+	Value []string `json:"value"`
+}
+
+// IsYandexPassport reports whether the account is a Yandex Passport account
+func (a *UserAccount) IsYandexPassport() bool {
+	return a.YandexPassportUserAccount != nil
+}
+
+// IsSAML reports whether the account is a federated (SAML) account
+func (a *UserAccount) IsSAML() bool {
+	return a.SAMLUserAccount != nil
+}
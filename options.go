@@ -0,0 +1,230 @@
+package yandexcloud
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/tigusigalpa/yandex-cloud-client-go/auth"
+	"github.com/tigusigalpa/yandex-cloud-client-go/resources"
+	"github.com/tigusigalpa/yandex-cloud-client-go/transport"
+)
+
+// Option configures a Client
+type Option func(*options)
+
+// options holds Client configuration
+type options struct {
+	oauthToken          string
+	credentials         auth.Credentials
+	serviceAccountKey   *auth.ServiceAccountKey
+	metadataAddress     string
+	defaultCredentials  bool
+	wifServiceAccountID string
+	wifSubjectToken     auth.SubjectTokenSource
+	wifSTSEndpoint      string
+	diskTokenCache      bool
+	diskTokenCacheDir   string
+	httpClient          *http.Client
+	endpoints           map[Service]string
+	endpointsURL        string
+	discoveryContext    context.Context
+	userAgentSuffix     string
+	timeout             time.Duration
+	retryPolicy         *transport.RetryPolicy
+	logger              *slog.Logger
+	logOptions          transport.LogOptions
+	middlewares         []transport.Middleware
+	rateLimits          map[Service]transport.RateLimit
+	pollPolicy          resources.PollPolicy
+}
+
+// defaultOptions returns default Client configuration
+func defaultOptions() *options {
+	return &options{
+		endpoints:        make(map[Service]string),
+		logOptions:       transport.DefaultLogOptions(),
+		pollPolicy:       resources.DefaultPollPolicy(),
+		discoveryContext: context.Background(),
+	}
+}
+
+// WithOAuthToken sets the Yandex Passport OAuth token used to obtain IAM tokens
+func WithOAuthToken(oauthToken string) Option {
+	return func(o *options) {
+		o.oauthToken = oauthToken
+	}
+}
+
+// WithCredentials sets the credentials used to authenticate requests instead of the OAuth token
+// (e.g. static IAM token, service account key or a custom provider)
+func WithCredentials(credentials auth.Credentials) Option {
+	return func(o *options) {
+		o.credentials = credentials
+	}
+}
+
+// WithServiceAccountKey authenticates as a service account using its authorized key
+// (see auth.LoadServiceAccountKeyFile)
+func WithServiceAccountKey(key *auth.ServiceAccountKey) Option {
+	return func(o *options) {
+		o.serviceAccountKey = key
+	}
+}
+
+// WithMetadataCredentials authenticates as the service account attached to the Compute instance,
+// Cloud Function or Serverless Container using the metadata service
+func WithMetadataCredentials() Option {
+	return WithMetadataAddress(auth.DefaultMetadataAddress)
+}
+
+// WithMetadataAddress is like WithMetadataCredentials but uses the metadata service at address
+func WithMetadataAddress(address string) Option {
+	return func(o *options) {
+		o.metadataAddress = address
+	}
+}
+
+// WithWorkloadIdentity authenticates as a federated service account by exchanging an external
+// OIDC token for an IAM token (see auth.SubjectTokenFromFile)
+func WithWorkloadIdentity(serviceAccountID string, subjectToken auth.SubjectTokenSource) Option {
+	return func(o *options) {
+		o.wifServiceAccountID = serviceAccountID
+		o.wifSubjectToken = subjectToken
+	}
+}
+
+// WithSTSEndpoint overrides the token exchange endpoint used by WithWorkloadIdentity
+// (auth.DefaultSTSEndpoint by default), e.g. to use a private installation or a local stand-in
+func WithSTSEndpoint(endpoint string) Option {
+	return func(o *options) {
+		o.wifSTSEndpoint = endpoint
+	}
+}
+
+// WithDefaultCredentials resolves credentials from the environment, the yc CLI config
+// and the metadata service (see auth.DefaultCredentials)
+func WithDefaultCredentials() Option {
+	return func(o *options) {
+		o.defaultCredentials = true
+	}
+}
+
+// WithDiskTokenCache shares IAM tokens between processes through an encrypted on-disk cache
+// in dir (auth.DefaultTokenCacheDir if empty)
+func WithDiskTokenCache(dir string) Option {
+	return func(o *options) {
+		o.diskTokenCache = true
+		o.diskTokenCacheDir = dir
+	}
+}
+
+// WithHTTPClient sets the HTTP client used for all requests. The client is copied with the client
+// transports installed and is not modified.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(o *options) {
+		if httpClient != nil {
+			o.httpClient = httpClient
+		}
+	}
+}
+
+// WithEndpoint overrides the base URI of a service (e.g. to use a private installation or a local stand-in)
+func WithEndpoint(service Service, baseURI string) Option {
+	return func(o *options) {
+		o.endpoints[service] = normalizeBaseURI(baseURI)
+	}
+}
+
+// WithEndpointDiscovery resolves service base URIs from the Yandex Cloud API endpoints list
+// (fetched once per process and cached). The static endpoint table is used if the list is unavailable
+// (failures are cached for a minute); endpoints set with WithEndpoint take precedence.
+// Use WithEndpointDiscoveryContext to bound the wait during client construction.
+func WithEndpointDiscovery() Option {
+	return WithEndpointDiscoveryURL(DefaultEndpointsURL)
+}
+
+// WithEndpointDiscoveryURL is like WithEndpointDiscovery but fetches the endpoints list from
+// endpointsURL (e.g. for an alternate installation)
+func WithEndpointDiscoveryURL(endpointsURL string) Option {
+	return func(o *options) {
+		o.endpointsURL = endpointsURL
+	}
+}
+
+// WithEndpointDiscoveryContext sets the context bounding the wait for the endpoints list
+// during client construction; if it is done first, the static endpoint table is used
+func WithEndpointDiscoveryContext(ctx context.Context) Option {
+	return func(o *options) {
+		o.discoveryContext = ctx
+	}
+}
+
+// WithUserAgent appends suffix to the User-Agent header sent with every request
+func WithUserAgent(suffix string) Option {
+	return func(o *options) {
+		o.userAgentSuffix = suffix
+	}
+}
+
+// WithTimeout sets the overall timeout of an HTTP call (http.Client.Timeout), including all retry
+// attempts and backoff delays of a retry policy. Use a context deadline to bound a single call.
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.timeout = timeout
+	}
+}
+
+// WithRetryPolicy enables retries of transient API failures (429, 502, 503, 504, connection resets).
+// Idempotent methods are retried, POST requests only when an idempotency key is supplied
+// with transport.WithIdempotencyKey. IAM token exchange is retried as well.
+func WithRetryPolicy(policy transport.RetryPolicy) Option {
+	return func(o *options) {
+		o.retryPolicy = &policy
+	}
+}
+
+// WithPollPolicy sets how Operations().Wait polls long-running operations,
+// e.g. short intervals in tests against a fake server
+func WithPollPolicy(policy resources.PollPolicy) Option {
+	return func(o *options) {
+		o.pollPolicy = policy
+	}
+}
+
+// WithRateLimit limits the request rate to service with a token bucket. The limit applies to the
+// final base URI of the service, so it follows WithEndpoint and endpoint discovery.
+// The limiter is shared by all resources created by the Client, including IAM token exchange.
+// Services on the same host share one limiter with the strictest of their limits.
+func WithRateLimit(service Service, limit transport.RateLimit) Option {
+	return func(o *options) {
+		if o.rateLimits == nil {
+			o.rateLimits = make(map[Service]transport.RateLimit)
+		}
+		o.rateLimits[service] = limit
+	}
+}
+
+// WithMiddleware adds middlewares wrapping every request made by the Client, including IAM token exchange.
+// Middlewares run once per call (outside of retries), in the order they are added.
+func WithMiddleware(middlewares ...transport.Middleware) Option {
+	return func(o *options) {
+		o.middlewares = append(o.middlewares, middlewares...)
+	}
+}
+
+// WithLogger logs requests and responses, including IAM token exchange, with logger.
+// Tokens, API key secrets and private keys are redacted.
+func WithLogger(logger *slog.Logger) Option {
+	return func(o *options) {
+		o.logger = logger
+	}
+}
+
+// WithLogOptions sets log levels and whether headers and bodies are logged (see transport.DefaultLogOptions)
+func WithLogOptions(logOptions transport.LogOptions) Option {
+	return func(o *options) {
+		o.logOptions = logOptions
+	}
+}
@@ -0,0 +1,97 @@
+package recorder
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// CassetteVersion is the version of the cassette format
+const CassetteVersion = 1
+
+// Cassette is a recorded sequence of HTTP interactions
+type Cassette struct {
+	Version      int           `json:"version" yaml:"version"`
+	Interactions []Interaction `json:"interactions" yaml:"interactions"`
+}
+
+// Interaction is a recorded request and its response
+type Interaction struct {
+	Request  Request  `json:"request" yaml:"request"`
+	Response Response `json:"response" yaml:"response"`
+}
+
+// Request is a recorded HTTP request with secrets redacted
+type Request struct {
+	Method  string      `json:"method" yaml:"method"`
+	URL     string      `json:"url" yaml:"url"`
+	Headers http.Header `json:"headers,omitempty" yaml:"headers,omitempty"`
+	Body    string      `json:"body,omitempty" yaml:"body,omitempty"`
+}
+
+// Response is a recorded HTTP response with secrets redacted
+type Response struct {
+	StatusCode int         `json:"statusCode" yaml:"statusCode"`
+	Headers    http.Header `json:"headers,omitempty" yaml:"headers,omitempty"`
+	Body       string      `json:"body,omitempty" yaml:"body,omitempty"`
+}
+
+// LoadCassette reads a cassette file (JSON if the extension is .json, YAML otherwise)
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("recorder: failed to read cassette: %w", err)
+	}
+
+	var cassette Cassette
+	if isJSON(path) {
+		err = json.Unmarshal(data, &cassette)
+	} else {
+		err = yaml.Unmarshal(data, &cassette)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("recorder: failed to parse cassette %s: %w", path, err)
+	}
+
+	if cassette.Version != CassetteVersion {
+		return nil, fmt.Errorf("recorder: unsupported cassette version %d in %s", cassette.Version, path)
+	}
+
+	return &cassette, nil
+}
+
+// Save writes the cassette file (JSON if the extension is .json, YAML otherwise), creating parent directories
+func (c *Cassette) Save(path string) error {
+	var (
+		data []byte
+		err  error
+	)
+	if isJSON(path) {
+		data, err = json.MarshalIndent(c, "", "  ")
+	} else {
+		data, err = yaml.Marshal(c)
+	}
+	if err != nil {
+		return fmt.Errorf("recorder: failed to encode cassette: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("recorder: failed to create cassette directory: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("recorder: failed to write cassette: %w", err)
+	}
+
+	return nil
+}
+
+// isJSON checks if the cassette file uses JSON format
+func isJSON(path string) bool {
+	return strings.EqualFold(filepath.Ext(path), ".json")
+}
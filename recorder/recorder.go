@@ -0,0 +1,271 @@
+// Package recorder records HTTP interactions with Yandex Cloud into cassette files and replays them,
+// so that integration tests run deterministically without network access.
+//
+//	rec, err := recorder.New("testdata/folders.yaml", recorder.ModeReplayOrRecord, nil)
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	defer rec.Stop()
+//
+//	client, err := yandexcloud.NewClient(os.Getenv("YC_TOKEN"), rec.Client())
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"reflect"
+	"sync"
+
+	"github.com/tigusigalpa/yandex-cloud-client-go/transport"
+)
+
+// Mode is the recorder mode
+type Mode int
+
+const (
+	// ModeReplay replays interactions from an existing cassette and never sends requests
+	ModeReplay Mode = iota
+	// ModeRecord sends requests and records them into a new cassette, overwriting an existing one
+	ModeRecord
+	// ModeReplayOrRecord replays if the cassette exists and records otherwise
+	ModeReplayOrRecord
+)
+
+// ErrInteractionNotFound is returned on replay when no recorded interaction matches a request
+var ErrInteractionNotFound = stderrors.New("recorder: no recorded interaction matches the request")
+
+// Matcher reports whether an incoming request (with secrets redacted) matches a recorded one
+type Matcher func(actual, recorded Request) bool
+
+// Recorder is an http.RoundTripper that records interactions into a cassette or replays them.
+// IAM tokens, OAuth tokens, API key secrets and private keys are redacted before recording.
+//
+// On replay each recorded interaction is used once, in order; when all matching interactions
+// have been used, the last one is repeated, e.g. for operation polling or token exchange.
+type Recorder struct {
+	path      string
+	base      http.RoundTripper
+	matcher   Matcher
+	recording bool
+
+	mu       sync.Mutex
+	cassette *Cassette
+	used     []bool
+}
+
+// New creates a recorder for the cassette at path.
+// Requests are sent through base (http.DefaultTransport if nil) when recording.
+func New(path string, mode Mode, base http.RoundTripper) (*Recorder, error) {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	r := &Recorder{
+		path:    path,
+		base:    base,
+		matcher: DefaultMatcher,
+	}
+
+	recording := mode == ModeRecord
+	if mode == ModeReplayOrRecord {
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			recording = true
+		}
+	}
+
+	if recording {
+		r.recording = true
+		r.cassette = &Cassette{Version: CassetteVersion}
+		return r, nil
+	}
+
+	cassette, err := LoadCassette(path)
+	if err != nil {
+		return nil, err
+	}
+	r.cassette = cassette
+	r.used = make([]bool, len(cassette.Interactions))
+
+	return r, nil
+}
+
+// SetMatcher sets the function matching requests to recorded interactions on replay
+func (r *Recorder) SetMatcher(matcher Matcher) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.matcher = matcher
+}
+
+// IsRecording checks if the recorder sends and records requests rather than replaying them
+func (r *Recorder) IsRecording() bool {
+	return r.recording
+}
+
+// Client returns an HTTP client using the recorder as its transport
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Stop saves the cassette if the recorder is recording
+func (r *Recorder) Stop() error {
+	if !r.recording {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.cassette.Save(r.path)
+}
+
+// RoundTrip implements http.RoundTripper
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := transport.ReadRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	request := newRequest(req, body)
+
+	if r.recording {
+		return r.record(req, request)
+	}
+	return r.replay(req, request)
+}
+
+// record sends the request and records the interaction
+func (r *Recorder) record(req *http.Request, request Request) (*http.Response, error) {
+	resp, err := r.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := transport.ReadResponseBody(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	// Redaction changes the body length, so the recorded length would be wrong
+	headers := redactHeaders(resp.Header)
+	headers.Del("Content-Length")
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: request,
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Headers:    headers,
+			Body:       string(transport.RedactBody(body)),
+		},
+	})
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+// replay returns the response of the first unused matching interaction
+func (r *Recorder) replay(req *http.Request, request Request) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	last := -1
+	for i, interaction := range r.cassette.Interactions {
+		if !r.matcher(request, interaction.Request) {
+			continue
+		}
+		last = i
+		if !r.used[i] {
+			break
+		}
+	}
+
+	if last < 0 {
+		return nil, fmt.Errorf("%w: %s %s", ErrInteractionNotFound, request.Method, request.URL)
+	}
+	r.used[last] = true
+
+	recorded := r.cassette.Interactions[last].Response
+	header := recorded.Headers.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader([]byte(recorded.Body))),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}, nil
+}
+
+// DefaultMatcher matches requests by method, path, query and body (JSON bodies are compared semantically)
+func DefaultMatcher(actual, recorded Request) bool {
+	if actual.Method != recorded.Method {
+		return false
+	}
+
+	actualURL, err := url.Parse(actual.URL)
+	if err != nil {
+		return false
+	}
+	recordedURL, err := url.Parse(recorded.URL)
+	if err != nil {
+		return false
+	}
+
+	if actualURL.Path != recordedURL.Path || !reflect.DeepEqual(actualURL.Query(), recordedURL.Query()) {
+		return false
+	}
+
+	return bodiesEqual(actual.Body, recorded.Body)
+}
+
+// bodiesEqual compares request bodies, ignoring JSON formatting and key order
+func bodiesEqual(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	var aValue, bValue interface{}
+	if json.Unmarshal([]byte(a), &aValue) != nil || json.Unmarshal([]byte(b), &bValue) != nil {
+		return false
+	}
+	return reflect.DeepEqual(aValue, bValue)
+}
+
+// newRequest converts an HTTP request to its recorded form
+func newRequest(req *http.Request, body []byte) Request {
+	return Request{
+		Method:  req.Method,
+		URL:     transport.RedactString(req.URL.String()),
+		Headers: redactHeaders(req.Header),
+		Body:    string(transport.RedactBody(body)),
+	}
+}
+
+// redactHeaders returns a copy of headers with secrets redacted
+func redactHeaders(header http.Header) http.Header {
+	if len(header) == 0 {
+		return nil
+	}
+
+	redacted := make(http.Header, len(header))
+	for name, values := range header {
+		for _, value := range values {
+			redacted.Add(name, transport.RedactHeader(name, value))
+		}
+	}
+	return redacted
+}
@@ -0,0 +1,227 @@
+package recorder_test
+
+import (
+	"context"
+	stderrors "errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	yandexcloud "github.com/tigusigalpa/yandex-cloud-client-go"
+	"github.com/tigusigalpa/yandex-cloud-client-go/models"
+	"github.com/tigusigalpa/yandex-cloud-client-go/recorder"
+	"github.com/tigusigalpa/yandex-cloud-client-go/yandexcloudtest"
+)
+
+// session creates a folder, waits for the operation and reads the folder back
+func session(t *testing.T, client *yandexcloud.Client, cloudID string) models.Folder {
+	t.Helper()
+	ctx := context.Background()
+
+	op, err := client.Folders().Create(ctx, cloudID, "recorded", nil, map[string]string{"env": "test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var created models.Folder
+	if _, err := client.Operations().Wait(ctx, op.ID, &created); err != nil {
+		t.Fatal(err)
+	}
+
+	folder, err := client.Folders().Get(ctx, created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return *folder
+}
+
+// recordSession records a session against a fake server and returns the cassette path,
+// the recorded folder and the (closed) server
+func recordSession(t *testing.T, name string) (string, models.Folder, *yandexcloudtest.Server) {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "testdata", name)
+
+	rec, err := recorder.New(path, recorder.ModeReplayOrRecord, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rec.IsRecording() {
+		t.Fatal("recorder replays a missing cassette")
+	}
+
+	server := yandexcloudtest.NewServer()
+	server.PendingPolls = 2
+	cloud := server.AddCloud(models.Cloud{Name: "cloud"})
+
+	client, err := server.Client(yandexcloud.WithHTTPClient(rec.Client()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	folder := session(t, client, cloud.ID)
+	server.Close()
+
+	if err := rec.Stop(); err != nil {
+		t.Fatal(err)
+	}
+
+	return path, folder, server
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	for _, name := range []string{"folders.yaml", "folders.json"} {
+		t.Run(name, func(t *testing.T) {
+			path, recorded, server := recordSession(t, name)
+
+			rec, err := recorder.New(path, recorder.ModeReplayOrRecord, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if rec.IsRecording() {
+				t.Fatal("recorder records over an existing cassette")
+			}
+
+			// The server is closed, so every response comes from the cassette
+			client, err := server.Client(yandexcloud.WithHTTPClient(rec.Client()))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			replayed := session(t, client, recorded.CloudID)
+			if replayed.ID != recorded.ID || replayed.Name != "recorded" || replayed.Labels["env"] != "test" {
+				t.Fatalf("replayed folder = %+v, recorded %+v", replayed, recorded)
+			}
+		})
+	}
+}
+
+func TestRecordRedactsSecrets(t *testing.T) {
+	path, _, _ := recordSession(t, "folders.yaml")
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, secret := range []string{yandexcloudtest.OAuthToken, "t1.yandexcloudtest"} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("cassette contains %q", secret)
+		}
+	}
+
+	cassette, err := recorder.LoadCassette(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, interaction := range cassette.Interactions {
+		if interaction.Response.Headers.Get("Content-Length") != "" {
+			t.Errorf("%s %s: Content-Length recorded", interaction.Request.Method, interaction.Request.URL)
+		}
+	}
+}
+
+func TestReplayNoMatch(t *testing.T) {
+	path, _, server := recordSession(t, "folders.yaml")
+
+	rec, err := recorder.New(path, recorder.ModeReplay, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := server.Client(yandexcloud.WithHTTPClient(rec.Client()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.Folders().Get(context.Background(), "b1gunknown")
+	if !stderrors.Is(err, recorder.ErrInteractionNotFound) {
+		t.Fatalf("err = %v, want %v", err, recorder.ErrInteractionNotFound)
+	}
+}
+
+func TestReplayRepeatsLastMatch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.yaml")
+	cassette := &recorder.Cassette{
+		Version: recorder.CassetteVersion,
+		Interactions: []recorder.Interaction{
+			{
+				Request:  recorder.Request{Method: "GET", URL: "https://example.com/operations/op1"},
+				Response: recorder.Response{StatusCode: 200, Body: `{"done":false}`},
+			},
+			{
+				Request:  recorder.Request{Method: "GET", URL: "https://example.com/operations/op1"},
+				Response: recorder.Response{StatusCode: 200, Body: `{"done":true}`},
+			},
+		},
+	}
+	if err := cassette.Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	rec, err := recorder.New(path, recorder.ModeReplay, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, want := range []string{`{"done":false}`, `{"done":true}`, `{"done":true}`} {
+		req, _ := http.NewRequest("GET", "https://example.com/operations/op1", nil)
+		resp, err := rec.RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(body) != want {
+			t.Errorf("replay %d = %s, want %s", i, body, want)
+		}
+	}
+}
+
+func TestLoadCassetteRejectsUnknownVersion(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.yaml")
+	if err := os.WriteFile(path, []byte("version: 99\ninteractions: []\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := recorder.LoadCassette(path); err == nil {
+		t.Fatal("cassette version 99 accepted")
+	}
+
+	if _, err := recorder.New(filepath.Join(t.TempDir(), "missing.yaml"), recorder.ModeReplay, nil); err == nil {
+		t.Fatal("missing cassette accepted in replay mode")
+	}
+}
+
+func TestDefaultMatcher(t *testing.T) {
+	recorded := recorder.Request{
+		Method: "POST",
+		URL:    "https://example.com/folders?b=2&a=1",
+		Body:   `{"name":"folder","labels":{"env":"test"}}`,
+	}
+
+	tests := []struct {
+		name   string
+		actual recorder.Request
+		want   bool
+	}{
+		{"reordered JSON and query", recorder.Request{Method: "POST", URL: "https://example.com/folders?a=1&b=2", Body: "{\"labels\": {\"env\": \"test\"},\n\"name\": \"folder\"}"}, true},
+		{"other method", recorder.Request{Method: "PUT", URL: recorded.URL, Body: recorded.Body}, false},
+		{"other path", recorder.Request{Method: "POST", URL: "https://example.com/clouds?b=2&a=1", Body: recorded.Body}, false},
+		{"other query", recorder.Request{Method: "POST", URL: "https://example.com/folders?a=1", Body: recorded.Body}, false},
+		{"other body", recorder.Request{Method: "POST", URL: recorded.URL, Body: `{"name":"other","labels":{"env":"test"}}`}, false},
+	}
+
+	for _, test := range tests {
+		if got := recorder.DefaultMatcher(test.actual, recorded); got != test.want {
+			t.Errorf("%s: DefaultMatcher = %v, want %v", test.name, got, test.want)
+		}
+	}
+}
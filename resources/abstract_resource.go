@@ -2,6 +2,7 @@ package resources
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -10,33 +11,65 @@ import (
 
 	"github.com/tigusigalpa/yandex-cloud-client-go/auth"
 	"github.com/tigusigalpa/yandex-cloud-client-go/errors"
+	"github.com/tigusigalpa/yandex-cloud-client-go/models"
+	"github.com/tigusigalpa/yandex-cloud-client-go/transport"
 )
 
 // AbstractResource provides common functionality for all resources
 type AbstractResource struct {
 	httpClient  *http.Client
-	authManager *auth.IAMTokenManager
+	credentials auth.Credentials
 	baseURI     string
+	service     string
+	name        string
 }
 
 // NewAbstractResource creates a new abstract resource
-func NewAbstractResource(httpClient *http.Client, authManager *auth.IAMTokenManager, baseURI string) *AbstractResource {
+func NewAbstractResource(httpClient *http.Client, credentials auth.Credentials, baseURI string) *AbstractResource {
+	return newAbstractResource(httpClient, credentials, baseURI, "", "")
+}
+
+// newAbstractResource creates a new abstract resource whose calls are named service.name.Method
+func newAbstractResource(httpClient *http.Client, credentials auth.Credentials, baseURI, service, name string) *AbstractResource {
 	if httpClient == nil {
 		httpClient = &http.Client{}
 	}
 	return &AbstractResource{
 		httpClient:  httpClient,
-		authManager: authManager,
+		credentials: credentials,
 		baseURI:     baseURI,
+		service:     service,
+		name:        name,
+	}
+}
+
+// call returns a context that identifies requests as made for method (used by tracing, metrics and logging)
+func (r *AbstractResource) call(ctx context.Context, method string) context.Context {
+	if r.service == "" {
+		return ctx
 	}
+	return transport.WithCallInfo(ctx, transport.CallInfo{
+		Service:  r.service,
+		Resource: r.name,
+		Method:   method,
+	})
 }
 
 // MakeRequest makes an HTTP request to Yandex Cloud API
-func (r *AbstractResource) MakeRequest(method, uri string, body interface{}) (map[string]interface{}, error) {
+func (r *AbstractResource) MakeRequest(ctx context.Context, method, uri string, body interface{}) (map[string]interface{}, error) {
+	data := make(map[string]interface{})
+	if err := r.MakeRequestInto(ctx, method, uri, body, &data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// MakeRequestInto makes an HTTP request to Yandex Cloud API and decodes the JSON response into out
+func (r *AbstractResource) MakeRequestInto(ctx context.Context, method, uri string, body interface{}, out interface{}) error {
 	// Get valid IAM token
-	iamToken, err := r.authManager.GetValidIAMToken()
+	iamToken, err := r.credentials.GetValidIAMToken(ctx)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// Prepare request body
@@ -44,16 +77,16 @@ func (r *AbstractResource) MakeRequest(method, uri string, body interface{}) (ma
 	if body != nil {
 		jsonData, err := json.Marshal(body)
 		if err != nil {
-			return nil, errors.NewAPIError("Failed to marshal request body", 0, err)
+			return errors.NewAPIError("Failed to marshal request body", 0, err)
 		}
 		reqBody = bytes.NewBuffer(jsonData)
 	}
 
 	// Create request
 	fullURL := r.baseURI + uri
-	req, err := http.NewRequest(method, fullURL, reqBody)
+	req, err := http.NewRequestWithContext(ctx, method, fullURL, reqBody)
 	if err != nil {
-		return nil, errors.NewAPIError("Failed to create request", 0, err)
+		return errors.NewAPIError("Failed to create request", 0, err)
 	}
 
 	// Set headers
@@ -64,39 +97,34 @@ func (r *AbstractResource) MakeRequest(method, uri string, body interface{}) (ma
 	// Execute request
 	resp, err := r.httpClient.Do(req)
 	if err != nil {
-		return nil, errors.NewAPIError("HTTP request failed", 0, err)
+		return errors.NewAPIError("HTTP request failed", 0, err)
 	}
 	defer resp.Body.Close()
 
-	return r.parseResponse(resp)
+	return r.parseResponse(resp, out)
 }
 
-// parseResponse parses HTTP response
-func (r *AbstractResource) parseResponse(resp *http.Response) (map[string]interface{}, error) {
+// parseResponse parses HTTP response into out
+func (r *AbstractResource) parseResponse(resp *http.Response, out interface{}) error {
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, errors.NewAPIError("Failed to read response body", resp.StatusCode, err)
+		return errors.NewAPIError("Failed to read response body", resp.StatusCode, err)
 	}
 
 	if resp.StatusCode >= 400 {
-		return nil, errors.NewAPIError(
-			fmt.Sprintf("API request failed with status %d: %s", resp.StatusCode, string(body)),
-			resp.StatusCode,
-			nil,
-		)
+		return errors.NewAPIErrorFromHTTPResponse(resp, body)
 	}
 
 	// Handle empty responses
-	if len(body) == 0 {
-		return make(map[string]interface{}), nil
+	if len(body) == 0 || out == nil {
+		return nil
 	}
 
-	var data map[string]interface{}
-	if err := json.Unmarshal(body, &data); err != nil {
-		return nil, errors.NewAPIError("Failed to parse JSON response", resp.StatusCode, err)
+	if err := json.Unmarshal(body, out); err != nil {
+		return errors.NewAPIError("Failed to parse JSON response", resp.StatusCode, err)
 	}
 
-	return data, nil
+	return nil
 }
 
 // BuildQueryString builds query string from parameters
@@ -112,3 +140,12 @@ func (r *AbstractResource) BuildQueryString(params map[string]interface{}) strin
 	}
 	return "?" + values.Encode()
 }
+
+// makeOperationRequest makes an HTTP request that returns a long-running operation
+func (r *AbstractResource) makeOperationRequest(ctx context.Context, method, uri string, body interface{}) (*models.Operation, error) {
+	var operation models.Operation
+	if err := r.MakeRequestInto(ctx, method, uri, body, &operation); err != nil {
+		return nil, err
+	}
+	return &operation, nil
+}
@@ -0,0 +1,116 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/tigusigalpa/yandex-cloud-client-go/models"
+)
+
+//go:generate moq -out mocks/mocks.go -pkg mocks . OrganizationsAPI CloudsAPI FoldersAPI RefreshTokensAPI ServiceAccountsAPI UserAccountsAPI YandexPassportUserAccountsAPI APIKeysAPI OperationsAPI
+
+// OrganizationsAPI is the Organization Manager organizations API implemented by OrganizationResource
+type OrganizationsAPI interface {
+	List(ctx context.Context, pageSize *int, pageToken *string) (*models.ListOrganizationsResponse, error)
+	ListAll(ctx context.Context, pageSize *int) *Pager[models.Organization]
+	Get(ctx context.Context, organizationID string) (*models.Organization, error)
+	Update(ctx context.Context, organizationID string, data map[string]interface{}) (*models.Operation, error)
+	ListAccessBindings(ctx context.Context, organizationID string, pageSize *int, pageToken *string) (*models.ListAccessBindingsResponse, error)
+	ListAllAccessBindings(ctx context.Context, organizationID string, pageSize *int) *Pager[models.AccessBinding]
+	UpdateAccessBindings(ctx context.Context, organizationID string, accessBindingDeltas []map[string]interface{}) (*models.Operation, error)
+	AddRole(ctx context.Context, organizationID, subjectID, roleID, subjectType string) (*models.Operation, error)
+	RemoveRole(ctx context.Context, organizationID, subjectID, roleID, subjectType string) (*models.Operation, error)
+}
+
+// CloudsAPI is the Resource Manager clouds API implemented by CloudResource
+type CloudsAPI interface {
+	List(ctx context.Context, organizationID *string, pageSize *int, pageToken *string) (*models.ListCloudsResponse, error)
+	ListAll(ctx context.Context, organizationID *string, pageSize *int) *Pager[models.Cloud]
+	Get(ctx context.Context, cloudID string) (*models.Cloud, error)
+	Create(ctx context.Context, organizationID, name string, description *string, labels map[string]string) (*models.Operation, error)
+	Update(ctx context.Context, cloudID string, data map[string]interface{}) (*models.Operation, error)
+	Delete(ctx context.Context, cloudID string) (*models.Operation, error)
+	SetAccessBindings(ctx context.Context, cloudID string, accessBindings []map[string]interface{}) (*models.Operation, error)
+	ListAccessBindings(ctx context.Context, cloudID string, pageSize *int, pageToken *string) (*models.ListAccessBindingsResponse, error)
+	ListAllAccessBindings(ctx context.Context, cloudID string, pageSize *int) *Pager[models.AccessBinding]
+	UpdateAccessBindings(ctx context.Context, cloudID string, accessBindingDeltas []map[string]interface{}) (*models.Operation, error)
+	AddRole(ctx context.Context, cloudID, subjectID, roleID, subjectType string) (*models.Operation, error)
+	RemoveRole(ctx context.Context, cloudID, subjectID, roleID, subjectType string) (*models.Operation, error)
+}
+
+// FoldersAPI is the Resource Manager folders API implemented by FolderResource
+type FoldersAPI interface {
+	List(ctx context.Context, cloudID string, pageSize *int, pageToken *string) (*models.ListFoldersResponse, error)
+	ListAll(ctx context.Context, cloudID string, pageSize *int) *Pager[models.Folder]
+	Get(ctx context.Context, folderID string) (*models.Folder, error)
+	Create(ctx context.Context, cloudID, name string, description *string, labels map[string]string) (*models.Operation, error)
+	Update(ctx context.Context, folderID string, data map[string]interface{}) (*models.Operation, error)
+	Delete(ctx context.Context, folderID string) (*models.Operation, error)
+	ListOperations(ctx context.Context, folderID string, pageSize *int, pageToken *string) (*models.ListOperationsResponse, error)
+	ListAllOperations(ctx context.Context, folderID string, pageSize *int) *Pager[models.Operation]
+	ListAccessBindings(ctx context.Context, folderID string, pageSize *int, pageToken *string) (*models.ListAccessBindingsResponse, error)
+	ListAllAccessBindings(ctx context.Context, folderID string, pageSize *int) *Pager[models.AccessBinding]
+	UpdateAccessBindings(ctx context.Context, folderID string, accessBindingDeltas []map[string]interface{}) (*models.Operation, error)
+	AddRole(ctx context.Context, folderID, subjectID, roleID, subjectType string) (*models.Operation, error)
+	RemoveRole(ctx context.Context, folderID, subjectID, roleID, subjectType string) (*models.Operation, error)
+}
+
+// RefreshTokensAPI is the IAM refresh tokens API implemented by RefreshTokenResource
+type RefreshTokensAPI interface {
+	List(ctx context.Context, pageSize *int, pageToken *string) (*models.ListRefreshTokensResponse, error)
+	ListAll(ctx context.Context, pageSize *int) *Pager[models.RefreshToken]
+	Revoke(ctx context.Context, tokenID string) (*models.Operation, error)
+}
+
+// ServiceAccountsAPI is the IAM service accounts API implemented by ServiceAccountResource
+type ServiceAccountsAPI interface {
+	List(ctx context.Context, folderID string, pageSize *int, pageToken *string) (*models.ListServiceAccountsResponse, error)
+	ListAll(ctx context.Context, folderID string, pageSize *int) *Pager[models.ServiceAccount]
+	Get(ctx context.Context, serviceAccountID string) (*models.ServiceAccount, error)
+	Create(ctx context.Context, folderID, name string, description *string) (*models.Operation, error)
+	Update(ctx context.Context, serviceAccountID string, data map[string]interface{}) (*models.Operation, error)
+	Delete(ctx context.Context, serviceAccountID string) (*models.Operation, error)
+	ListAccessBindings(ctx context.Context, serviceAccountID string, pageSize *int, pageToken *string) (*models.ListAccessBindingsResponse, error)
+	ListAllAccessBindings(ctx context.Context, serviceAccountID string, pageSize *int) *Pager[models.AccessBinding]
+	UpdateAccessBindings(ctx context.Context, serviceAccountID string, accessBindingDeltas []map[string]interface{}) (*models.Operation, error)
+	AddRole(ctx context.Context, serviceAccountID, subjectID, roleID, subjectType string) (*models.Operation, error)
+	RemoveRole(ctx context.Context, serviceAccountID, subjectID, roleID, subjectType string) (*models.Operation, error)
+}
+
+// UserAccountsAPI is the IAM user accounts API implemented by UserAccountResource
+type UserAccountsAPI interface {
+	Get(ctx context.Context, userAccountID string) (*models.UserAccount, error)
+}
+
+// YandexPassportUserAccountsAPI is the IAM Yandex Passport user accounts API implemented by YandexPassportUserAccountResource
+type YandexPassportUserAccountsAPI interface {
+	GetByLogin(ctx context.Context, login string) (*models.UserAccount, error)
+}
+
+// APIKeysAPI is the IAM API keys API implemented by APIKeyResource
+type APIKeysAPI interface {
+	List(ctx context.Context, serviceAccountID string, pageSize *int, pageToken *string) (*models.ListAPIKeysResponse, error)
+	ListAll(ctx context.Context, serviceAccountID string, pageSize *int) *Pager[models.APIKey]
+	Get(ctx context.Context, apiKeyID string) (*models.APIKey, error)
+	Create(ctx context.Context, serviceAccountID string, description *string) (*models.CreateAPIKeyResponse, error)
+	Update(ctx context.Context, apiKeyID string, data map[string]interface{}) (*models.Operation, error)
+	Delete(ctx context.Context, apiKeyID string) (*models.Operation, error)
+}
+
+// OperationsAPI is the Operation service API implemented by OperationResource
+type OperationsAPI interface {
+	Get(ctx context.Context, operationID string) (*models.Operation, error)
+	Cancel(ctx context.Context, operationID string) (*models.Operation, error)
+	Wait(ctx context.Context, operationID string, response interface{}) (*models.Operation, error)
+}
+
+var (
+	_ OrganizationsAPI              = (*OrganizationResource)(nil)
+	_ CloudsAPI                     = (*CloudResource)(nil)
+	_ FoldersAPI                    = (*FolderResource)(nil)
+	_ RefreshTokensAPI              = (*RefreshTokenResource)(nil)
+	_ ServiceAccountsAPI            = (*ServiceAccountResource)(nil)
+	_ UserAccountsAPI               = (*UserAccountResource)(nil)
+	_ YandexPassportUserAccountsAPI = (*YandexPassportUserAccountResource)(nil)
+	_ APIKeysAPI                    = (*APIKeyResource)(nil)
+	_ OperationsAPI                 = (*OperationResource)(nil)
+)
@@ -1,10 +1,12 @@
 package resources
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/tigusigalpa/yandex-cloud-client-go/auth"
 	"github.com/tigusigalpa/yandex-cloud-client-go/errors"
+	"github.com/tigusigalpa/yandex-cloud-client-go/models"
 )
 
 // APIKeyResource handles API key-related operations
@@ -13,14 +15,16 @@ type APIKeyResource struct {
 }
 
 // NewAPIKeyResource creates a new API key resource
-func NewAPIKeyResource(httpClient *http.Client, authManager *auth.IAMTokenManager, baseURI string) *APIKeyResource {
+func NewAPIKeyResource(httpClient *http.Client, credentials auth.Credentials, baseURI string) *APIKeyResource {
 	return &APIKeyResource{
-		AbstractResource: NewAbstractResource(httpClient, authManager, baseURI),
+		AbstractResource: newAbstractResource(httpClient, credentials, baseURI, "iam", "APIKeys"),
 	}
 }
 
 // List gets list of API keys for service account
-func (r *APIKeyResource) List(serviceAccountID string, pageSize *int, pageToken *string) (map[string]interface{}, error) {
+func (r *APIKeyResource) List(ctx context.Context, serviceAccountID string, pageSize *int, pageToken *string) (*models.ListAPIKeysResponse, error) {
+	ctx = r.call(ctx, "List")
+
 	params := make(map[string]interface{})
 	params["serviceAccountId"] = serviceAccountID
 
@@ -32,20 +36,43 @@ func (r *APIKeyResource) List(serviceAccountID string, pageSize *int, pageToken
 	}
 
 	query := r.BuildQueryString(params)
-	return r.MakeRequest("GET", "iam/v1/apiKeys"+query, nil)
+	var response models.ListAPIKeysResponse
+	if err := r.MakeRequestInto(ctx, "GET", "iam/v1/apiKeys"+query, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// ListAll returns a pager over all API keys for service account
+func (r *APIKeyResource) ListAll(ctx context.Context, serviceAccountID string, pageSize *int) *Pager[models.APIKey] {
+	return newPager(ctx, func(ctx context.Context, pageToken *string) ([]models.APIKey, string, error) {
+		response, err := r.List(ctx, serviceAccountID, pageSize, pageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		return response.APIKeys, response.NextPageToken, nil
+	})
 }
 
 // Get gets API key details
-func (r *APIKeyResource) Get(apiKeyID string) (map[string]interface{}, error) {
+func (r *APIKeyResource) Get(ctx context.Context, apiKeyID string) (*models.APIKey, error) {
+	ctx = r.call(ctx, "Get")
+
 	if apiKeyID == "" {
 		return nil, errors.NewValidationError("API key ID cannot be empty")
 	}
 
-	return r.MakeRequest("GET", "iam/v1/apiKeys/"+apiKeyID, nil)
+	var apiKey models.APIKey
+	if err := r.MakeRequestInto(ctx, "GET", "iam/v1/apiKeys/"+apiKeyID, nil, &apiKey); err != nil {
+		return nil, err
+	}
+	return &apiKey, nil
 }
 
 // Create creates a new API key
-func (r *APIKeyResource) Create(serviceAccountID string, description *string) (map[string]interface{}, error) {
+func (r *APIKeyResource) Create(ctx context.Context, serviceAccountID string, description *string) (*models.CreateAPIKeyResponse, error) {
+	ctx = r.call(ctx, "Create")
+
 	if serviceAccountID == "" {
 		return nil, errors.NewValidationError("Service account ID cannot be empty")
 	}
@@ -58,11 +85,17 @@ func (r *APIKeyResource) Create(serviceAccountID string, description *string) (m
 		data["description"] = *description
 	}
 
-	return r.MakeRequest("POST", "iam/v1/apiKeys", data)
+	var response models.CreateAPIKeyResponse
+	if err := r.MakeRequestInto(ctx, "POST", "iam/v1/apiKeys", data, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
 }
 
 // Update updates API key
-func (r *APIKeyResource) Update(apiKeyID string, data map[string]interface{}) (map[string]interface{}, error) {
+func (r *APIKeyResource) Update(ctx context.Context, apiKeyID string, data map[string]interface{}) (*models.Operation, error) {
+	ctx = r.call(ctx, "Update")
+
 	if apiKeyID == "" {
 		return nil, errors.NewValidationError("API key ID cannot be empty")
 	}
@@ -71,14 +104,16 @@ func (r *APIKeyResource) Update(apiKeyID string, data map[string]interface{}) (m
 		return nil, errors.NewValidationError("Update data cannot be empty")
 	}
 
-	return r.MakeRequest("PATCH", "iam/v1/apiKeys/"+apiKeyID, data)
+	return r.makeOperationRequest(ctx, "PATCH", "iam/v1/apiKeys/"+apiKeyID, data)
 }
 
 // Delete deletes API key
-func (r *APIKeyResource) Delete(apiKeyID string) (map[string]interface{}, error) {
+func (r *APIKeyResource) Delete(ctx context.Context, apiKeyID string) (*models.Operation, error) {
+	ctx = r.call(ctx, "Delete")
+
 	if apiKeyID == "" {
 		return nil, errors.NewValidationError("API key ID cannot be empty")
 	}
 
-	return r.MakeRequest("DELETE", "iam/v1/apiKeys/"+apiKeyID, nil)
+	return r.makeOperationRequest(ctx, "DELETE", "iam/v1/apiKeys/"+apiKeyID, nil)
 }
@@ -1,10 +1,12 @@
 package resources
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/tigusigalpa/yandex-cloud-client-go/auth"
 	"github.com/tigusigalpa/yandex-cloud-client-go/errors"
+	"github.com/tigusigalpa/yandex-cloud-client-go/models"
 )
 
 // CloudResource handles cloud-related operations
@@ -13,14 +15,16 @@ type CloudResource struct {
 }
 
 // NewCloudResource creates a new cloud resource
-func NewCloudResource(httpClient *http.Client, authManager *auth.IAMTokenManager, baseURI string) *CloudResource {
+func NewCloudResource(httpClient *http.Client, credentials auth.Credentials, baseURI string) *CloudResource {
 	return &CloudResource{
-		AbstractResource: NewAbstractResource(httpClient, authManager, baseURI),
+		AbstractResource: newAbstractResource(httpClient, credentials, baseURI, "resourcemanager", "Clouds"),
 	}
 }
 
 // List gets list of clouds
-func (r *CloudResource) List(organizationID *string, pageSize *int, pageToken *string) (map[string]interface{}, error) {
+func (r *CloudResource) List(ctx context.Context, organizationID *string, pageSize *int, pageToken *string) (*models.ListCloudsResponse, error) {
+	ctx = r.call(ctx, "List")
+
 	params := make(map[string]interface{})
 	if organizationID != nil {
 		params["organizationId"] = *organizationID
@@ -33,20 +37,43 @@ func (r *CloudResource) List(organizationID *string, pageSize *int, pageToken *s
 	}
 
 	query := r.BuildQueryString(params)
-	return r.MakeRequest("GET", "resource-manager/v1/clouds"+query, nil)
+	var response models.ListCloudsResponse
+	if err := r.MakeRequestInto(ctx, "GET", "resource-manager/v1/clouds"+query, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// ListAll returns a pager over all clouds
+func (r *CloudResource) ListAll(ctx context.Context, organizationID *string, pageSize *int) *Pager[models.Cloud] {
+	return newPager(ctx, func(ctx context.Context, pageToken *string) ([]models.Cloud, string, error) {
+		response, err := r.List(ctx, organizationID, pageSize, pageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		return response.Clouds, response.NextPageToken, nil
+	})
 }
 
 // Get gets cloud details
-func (r *CloudResource) Get(cloudID string) (map[string]interface{}, error) {
+func (r *CloudResource) Get(ctx context.Context, cloudID string) (*models.Cloud, error) {
+	ctx = r.call(ctx, "Get")
+
 	if cloudID == "" {
 		return nil, errors.NewValidationError("Cloud ID cannot be empty")
 	}
 
-	return r.MakeRequest("GET", "resource-manager/v1/clouds/"+cloudID, nil)
+	var cloud models.Cloud
+	if err := r.MakeRequestInto(ctx, "GET", "resource-manager/v1/clouds/"+cloudID, nil, &cloud); err != nil {
+		return nil, err
+	}
+	return &cloud, nil
 }
 
 // Create creates a new cloud
-func (r *CloudResource) Create(organizationID, name string, description *string, labels map[string]string) (map[string]interface{}, error) {
+func (r *CloudResource) Create(ctx context.Context, organizationID, name string, description *string, labels map[string]string) (*models.Operation, error) {
+	ctx = r.call(ctx, "Create")
+
 	if organizationID == "" {
 		return nil, errors.NewValidationError("Organization ID cannot be empty")
 	}
@@ -68,11 +95,13 @@ func (r *CloudResource) Create(organizationID, name string, description *string,
 		data["labels"] = labels
 	}
 
-	return r.MakeRequest("POST", "resource-manager/v1/clouds", data)
+	return r.makeOperationRequest(ctx, "POST", "resource-manager/v1/clouds", data)
 }
 
 // Update updates cloud
-func (r *CloudResource) Update(cloudID string, data map[string]interface{}) (map[string]interface{}, error) {
+func (r *CloudResource) Update(ctx context.Context, cloudID string, data map[string]interface{}) (*models.Operation, error) {
+	ctx = r.call(ctx, "Update")
+
 	if cloudID == "" {
 		return nil, errors.NewValidationError("Cloud ID cannot be empty")
 	}
@@ -81,20 +110,24 @@ func (r *CloudResource) Update(cloudID string, data map[string]interface{}) (map
 		return nil, errors.NewValidationError("Update data cannot be empty")
 	}
 
-	return r.MakeRequest("PATCH", "resource-manager/v1/clouds/"+cloudID, data)
+	return r.makeOperationRequest(ctx, "PATCH", "resource-manager/v1/clouds/"+cloudID, data)
 }
 
 // Delete deletes cloud
-func (r *CloudResource) Delete(cloudID string) (map[string]interface{}, error) {
+func (r *CloudResource) Delete(ctx context.Context, cloudID string) (*models.Operation, error) {
+	ctx = r.call(ctx, "Delete")
+
 	if cloudID == "" {
 		return nil, errors.NewValidationError("Cloud ID cannot be empty")
 	}
 
-	return r.MakeRequest("DELETE", "resource-manager/v1/clouds/"+cloudID, nil)
+	return r.makeOperationRequest(ctx, "DELETE", "resource-manager/v1/clouds/"+cloudID, nil)
 }
 
 // SetAccessBindings sets access bindings for cloud
-func (r *CloudResource) SetAccessBindings(cloudID string, accessBindings []map[string]interface{}) (map[string]interface{}, error) {
+func (r *CloudResource) SetAccessBindings(ctx context.Context, cloudID string, accessBindings []map[string]interface{}) (*models.Operation, error) {
+	ctx = r.call(ctx, "SetAccessBindings")
+
 	if cloudID == "" {
 		return nil, errors.NewValidationError("Cloud ID cannot be empty")
 	}
@@ -107,11 +140,13 @@ func (r *CloudResource) SetAccessBindings(cloudID string, accessBindings []map[s
 		"accessBindings": accessBindings,
 	}
 
-	return r.MakeRequest("POST", "resource-manager/v1/clouds/"+cloudID+":setAccessBindings", body)
+	return r.makeOperationRequest(ctx, "POST", "resource-manager/v1/clouds/"+cloudID+":setAccessBindings", body)
 }
 
 // ListAccessBindings lists access bindings for cloud
-func (r *CloudResource) ListAccessBindings(cloudID string, pageSize *int, pageToken *string) (map[string]interface{}, error) {
+func (r *CloudResource) ListAccessBindings(ctx context.Context, cloudID string, pageSize *int, pageToken *string) (*models.ListAccessBindingsResponse, error) {
+	ctx = r.call(ctx, "ListAccessBindings")
+
 	if cloudID == "" {
 		return nil, errors.NewValidationError("Cloud ID cannot be empty")
 	}
@@ -125,11 +160,28 @@ func (r *CloudResource) ListAccessBindings(cloudID string, pageSize *int, pageTo
 	}
 
 	query := r.BuildQueryString(params)
-	return r.MakeRequest("GET", "resource-manager/v1/clouds/"+cloudID+":listAccessBindings"+query, nil)
+	var response models.ListAccessBindingsResponse
+	if err := r.MakeRequestInto(ctx, "GET", "resource-manager/v1/clouds/"+cloudID+":listAccessBindings"+query, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// ListAllAccessBindings returns a pager over all access bindings for cloud
+func (r *CloudResource) ListAllAccessBindings(ctx context.Context, cloudID string, pageSize *int) *Pager[models.AccessBinding] {
+	return newPager(ctx, func(ctx context.Context, pageToken *string) ([]models.AccessBinding, string, error) {
+		response, err := r.ListAccessBindings(ctx, cloudID, pageSize, pageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		return response.AccessBindings, response.NextPageToken, nil
+	})
 }
 
 // UpdateAccessBindings updates access bindings for cloud
-func (r *CloudResource) UpdateAccessBindings(cloudID string, accessBindingDeltas []map[string]interface{}) (map[string]interface{}, error) {
+func (r *CloudResource) UpdateAccessBindings(ctx context.Context, cloudID string, accessBindingDeltas []map[string]interface{}) (*models.Operation, error) {
+	ctx = r.call(ctx, "UpdateAccessBindings")
+
 	if cloudID == "" {
 		return nil, errors.NewValidationError("Cloud ID cannot be empty")
 	}
@@ -142,11 +194,11 @@ func (r *CloudResource) UpdateAccessBindings(cloudID string, accessBindingDeltas
 		"accessBindingDeltas": accessBindingDeltas,
 	}
 
-	return r.MakeRequest("POST", "resource-manager/v1/clouds/"+cloudID+":updateAccessBindings", body)
+	return r.makeOperationRequest(ctx, "POST", "resource-manager/v1/clouds/"+cloudID+":updateAccessBindings", body)
 }
 
 // AddRole adds a role to cloud (helper method)
-func (r *CloudResource) AddRole(cloudID, subjectID, roleID, subjectType string) (map[string]interface{}, error) {
+func (r *CloudResource) AddRole(ctx context.Context, cloudID, subjectID, roleID, subjectType string) (*models.Operation, error) {
 	if subjectType == "" {
 		subjectType = "userAccount"
 	}
@@ -164,11 +216,11 @@ func (r *CloudResource) AddRole(cloudID, subjectID, roleID, subjectType string)
 		},
 	}
 
-	return r.UpdateAccessBindings(cloudID, deltas)
+	return r.UpdateAccessBindings(ctx, cloudID, deltas)
 }
 
 // RemoveRole removes a role from cloud (helper method)
-func (r *CloudResource) RemoveRole(cloudID, subjectID, roleID, subjectType string) (map[string]interface{}, error) {
+func (r *CloudResource) RemoveRole(ctx context.Context, cloudID, subjectID, roleID, subjectType string) (*models.Operation, error) {
 	if subjectType == "" {
 		subjectType = "userAccount"
 	}
@@ -186,5 +238,5 @@ func (r *CloudResource) RemoveRole(cloudID, subjectID, roleID, subjectType strin
 		},
 	}
 
-	return r.UpdateAccessBindings(cloudID, deltas)
+	return r.UpdateAccessBindings(ctx, cloudID, deltas)
 }
@@ -1,10 +1,12 @@
 package resources
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/tigusigalpa/yandex-cloud-client-go/auth"
 	"github.com/tigusigalpa/yandex-cloud-client-go/errors"
+	"github.com/tigusigalpa/yandex-cloud-client-go/models"
 )
 
 // FolderResource handles folder-related operations
@@ -13,14 +15,16 @@ type FolderResource struct {
 }
 
 // NewFolderResource creates a new folder resource
-func NewFolderResource(httpClient *http.Client, authManager *auth.IAMTokenManager, baseURI string) *FolderResource {
+func NewFolderResource(httpClient *http.Client, credentials auth.Credentials, baseURI string) *FolderResource {
 	return &FolderResource{
-		AbstractResource: NewAbstractResource(httpClient, authManager, baseURI),
+		AbstractResource: newAbstractResource(httpClient, credentials, baseURI, "resourcemanager", "Folders"),
 	}
 }
 
 // List gets list of folders
-func (r *FolderResource) List(cloudID string, pageSize *int, pageToken *string) (map[string]interface{}, error) {
+func (r *FolderResource) List(ctx context.Context, cloudID string, pageSize *int, pageToken *string) (*models.ListFoldersResponse, error) {
+	ctx = r.call(ctx, "List")
+
 	params := make(map[string]interface{})
 	params["cloudId"] = cloudID
 
@@ -32,20 +36,43 @@ func (r *FolderResource) List(cloudID string, pageSize *int, pageToken *string)
 	}
 
 	query := r.BuildQueryString(params)
-	return r.MakeRequest("GET", "resource-manager/v1/folders"+query, nil)
+	var response models.ListFoldersResponse
+	if err := r.MakeRequestInto(ctx, "GET", "resource-manager/v1/folders"+query, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// ListAll returns a pager over all folders in cloud
+func (r *FolderResource) ListAll(ctx context.Context, cloudID string, pageSize *int) *Pager[models.Folder] {
+	return newPager(ctx, func(ctx context.Context, pageToken *string) ([]models.Folder, string, error) {
+		response, err := r.List(ctx, cloudID, pageSize, pageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		return response.Folders, response.NextPageToken, nil
+	})
 }
 
 // Get gets folder details
-func (r *FolderResource) Get(folderID string) (map[string]interface{}, error) {
+func (r *FolderResource) Get(ctx context.Context, folderID string) (*models.Folder, error) {
+	ctx = r.call(ctx, "Get")
+
 	if folderID == "" {
 		return nil, errors.NewValidationError("Folder ID cannot be empty")
 	}
 
-	return r.MakeRequest("GET", "resource-manager/v1/folders/"+folderID, nil)
+	var folder models.Folder
+	if err := r.MakeRequestInto(ctx, "GET", "resource-manager/v1/folders/"+folderID, nil, &folder); err != nil {
+		return nil, err
+	}
+	return &folder, nil
 }
 
 // Create creates a new folder
-func (r *FolderResource) Create(cloudID, name string, description *string, labels map[string]string) (map[string]interface{}, error) {
+func (r *FolderResource) Create(ctx context.Context, cloudID, name string, description *string, labels map[string]string) (*models.Operation, error) {
+	ctx = r.call(ctx, "Create")
+
 	if cloudID == "" {
 		return nil, errors.NewValidationError("Cloud ID cannot be empty")
 	}
@@ -67,11 +94,13 @@ func (r *FolderResource) Create(cloudID, name string, description *string, label
 		data["labels"] = labels
 	}
 
-	return r.MakeRequest("POST", "resource-manager/v1/folders", data)
+	return r.makeOperationRequest(ctx, "POST", "resource-manager/v1/folders", data)
 }
 
 // Update updates folder
-func (r *FolderResource) Update(folderID string, data map[string]interface{}) (map[string]interface{}, error) {
+func (r *FolderResource) Update(ctx context.Context, folderID string, data map[string]interface{}) (*models.Operation, error) {
+	ctx = r.call(ctx, "Update")
+
 	if folderID == "" {
 		return nil, errors.NewValidationError("Folder ID cannot be empty")
 	}
@@ -80,20 +109,24 @@ func (r *FolderResource) Update(folderID string, data map[string]interface{}) (m
 		return nil, errors.NewValidationError("Update data cannot be empty")
 	}
 
-	return r.MakeRequest("PATCH", "resource-manager/v1/folders/"+folderID, data)
+	return r.makeOperationRequest(ctx, "PATCH", "resource-manager/v1/folders/"+folderID, data)
 }
 
 // Delete deletes folder
-func (r *FolderResource) Delete(folderID string) (map[string]interface{}, error) {
+func (r *FolderResource) Delete(ctx context.Context, folderID string) (*models.Operation, error) {
+	ctx = r.call(ctx, "Delete")
+
 	if folderID == "" {
 		return nil, errors.NewValidationError("Folder ID cannot be empty")
 	}
 
-	return r.MakeRequest("DELETE", "resource-manager/v1/folders/"+folderID, nil)
+	return r.makeOperationRequest(ctx, "DELETE", "resource-manager/v1/folders/"+folderID, nil)
 }
 
 // ListOperations lists operations for folder
-func (r *FolderResource) ListOperations(folderID string, pageSize *int, pageToken *string) (map[string]interface{}, error) {
+func (r *FolderResource) ListOperations(ctx context.Context, folderID string, pageSize *int, pageToken *string) (*models.ListOperationsResponse, error) {
+	ctx = r.call(ctx, "ListOperations")
+
 	if folderID == "" {
 		return nil, errors.NewValidationError("Folder ID cannot be empty")
 	}
@@ -107,11 +140,28 @@ func (r *FolderResource) ListOperations(folderID string, pageSize *int, pageToke
 	}
 
 	query := r.BuildQueryString(params)
-	return r.MakeRequest("GET", "resource-manager/v1/folders/"+folderID+"/operations"+query, nil)
+	var response models.ListOperationsResponse
+	if err := r.MakeRequestInto(ctx, "GET", "resource-manager/v1/folders/"+folderID+"/operations"+query, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// ListAllOperations returns a pager over all operations for folder
+func (r *FolderResource) ListAllOperations(ctx context.Context, folderID string, pageSize *int) *Pager[models.Operation] {
+	return newPager(ctx, func(ctx context.Context, pageToken *string) ([]models.Operation, string, error) {
+		response, err := r.ListOperations(ctx, folderID, pageSize, pageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		return response.Operations, response.NextPageToken, nil
+	})
 }
 
 // ListAccessBindings lists access bindings for folder
-func (r *FolderResource) ListAccessBindings(folderID string, pageSize *int, pageToken *string) (map[string]interface{}, error) {
+func (r *FolderResource) ListAccessBindings(ctx context.Context, folderID string, pageSize *int, pageToken *string) (*models.ListAccessBindingsResponse, error) {
+	ctx = r.call(ctx, "ListAccessBindings")
+
 	if folderID == "" {
 		return nil, errors.NewValidationError("Folder ID cannot be empty")
 	}
@@ -125,11 +175,28 @@ func (r *FolderResource) ListAccessBindings(folderID string, pageSize *int, page
 	}
 
 	query := r.BuildQueryString(params)
-	return r.MakeRequest("GET", "resource-manager/v1/folders/"+folderID+":listAccessBindings"+query, nil)
+	var response models.ListAccessBindingsResponse
+	if err := r.MakeRequestInto(ctx, "GET", "resource-manager/v1/folders/"+folderID+":listAccessBindings"+query, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// ListAllAccessBindings returns a pager over all access bindings for folder
+func (r *FolderResource) ListAllAccessBindings(ctx context.Context, folderID string, pageSize *int) *Pager[models.AccessBinding] {
+	return newPager(ctx, func(ctx context.Context, pageToken *string) ([]models.AccessBinding, string, error) {
+		response, err := r.ListAccessBindings(ctx, folderID, pageSize, pageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		return response.AccessBindings, response.NextPageToken, nil
+	})
 }
 
 // UpdateAccessBindings updates access bindings for folder
-func (r *FolderResource) UpdateAccessBindings(folderID string, accessBindingDeltas []map[string]interface{}) (map[string]interface{}, error) {
+func (r *FolderResource) UpdateAccessBindings(ctx context.Context, folderID string, accessBindingDeltas []map[string]interface{}) (*models.Operation, error) {
+	ctx = r.call(ctx, "UpdateAccessBindings")
+
 	if folderID == "" {
 		return nil, errors.NewValidationError("Folder ID cannot be empty")
 	}
@@ -142,11 +209,11 @@ func (r *FolderResource) UpdateAccessBindings(folderID string, accessBindingDelt
 		"accessBindingDeltas": accessBindingDeltas,
 	}
 
-	return r.MakeRequest("POST", "resource-manager/v1/folders/"+folderID+":updateAccessBindings", body)
+	return r.makeOperationRequest(ctx, "POST", "resource-manager/v1/folders/"+folderID+":updateAccessBindings", body)
 }
 
 // AddRole adds a role to folder (helper method)
-func (r *FolderResource) AddRole(folderID, subjectID, roleID, subjectType string) (map[string]interface{}, error) {
+func (r *FolderResource) AddRole(ctx context.Context, folderID, subjectID, roleID, subjectType string) (*models.Operation, error) {
 	if subjectType == "" {
 		subjectType = "userAccount"
 	}
@@ -164,11 +231,11 @@ func (r *FolderResource) AddRole(folderID, subjectID, roleID, subjectType string
 		},
 	}
 
-	return r.UpdateAccessBindings(folderID, deltas)
+	return r.UpdateAccessBindings(ctx, folderID, deltas)
 }
 
 // RemoveRole removes a role from folder (helper method)
-func (r *FolderResource) RemoveRole(folderID, subjectID, roleID, subjectType string) (map[string]interface{}, error) {
+func (r *FolderResource) RemoveRole(ctx context.Context, folderID, subjectID, roleID, subjectType string) (*models.Operation, error) {
 	if subjectType == "" {
 		subjectType = "userAccount"
 	}
@@ -186,5 +253,5 @@ func (r *FolderResource) RemoveRole(folderID, subjectID, roleID, subjectType str
 		},
 	}
 
-	return r.UpdateAccessBindings(folderID, deltas)
+	return r.UpdateAccessBindings(ctx, folderID, deltas)
 }
@@ -0,0 +1,136 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/tigusigalpa/yandex-cloud-client-go/auth"
+	"github.com/tigusigalpa/yandex-cloud-client-go/errors"
+	"github.com/tigusigalpa/yandex-cloud-client-go/models"
+)
+
+// PollPolicy configures how Wait polls long-running operations
+type PollPolicy struct {
+	// InitialInterval is the delay before the second poll
+	InitialInterval time.Duration
+	// MaxInterval caps the delay between polls
+	MaxInterval time.Duration
+	// Multiplier increases the delay after each poll
+	Multiplier float64
+}
+
+// DefaultPollPolicy returns the default poll policy
+func DefaultPollPolicy() PollPolicy {
+	return PollPolicy{
+		InitialInterval: 500 * time.Millisecond,
+		MaxInterval:     10 * time.Second,
+		Multiplier:      2,
+	}
+}
+
+// OperationResource handles long-running operation-related operations
+type OperationResource struct {
+	*AbstractResource
+	pollPolicy PollPolicy
+}
+
+// NewOperationResource creates a new operation resource
+func NewOperationResource(httpClient *http.Client, credentials auth.Credentials, baseURI string) *OperationResource {
+	return &OperationResource{
+		AbstractResource: newAbstractResource(httpClient, credentials, baseURI, "operation", "Operations"),
+		pollPolicy:       DefaultPollPolicy(),
+	}
+}
+
+// SetPollPolicy sets the policy used by Wait to poll operations
+func (r *OperationResource) SetPollPolicy(policy PollPolicy) {
+	if policy.Multiplier < 1 {
+		policy.Multiplier = 1
+	}
+	r.pollPolicy = policy
+}
+
+// Get gets operation status
+func (r *OperationResource) Get(ctx context.Context, operationID string) (*models.Operation, error) {
+	ctx = r.call(ctx, "Get")
+
+	if operationID == "" {
+		return nil, errors.NewValidationError("Operation ID cannot be empty")
+	}
+
+	var operation models.Operation
+	if err := r.MakeRequestInto(ctx, "GET", "operations/"+operationID, nil, &operation); err != nil {
+		return nil, err
+	}
+	return &operation, nil
+}
+
+// Cancel cancels operation (if the operation supports cancellation)
+func (r *OperationResource) Cancel(ctx context.Context, operationID string) (*models.Operation, error) {
+	ctx = r.call(ctx, "Cancel")
+
+	if operationID == "" {
+		return nil, errors.NewValidationError("Operation ID cannot be empty")
+	}
+
+	var operation models.Operation
+	if err := r.MakeRequestInto(ctx, "GET", "operations/"+operationID+":cancel", nil, &operation); err != nil {
+		return nil, err
+	}
+	return &operation, nil
+}
+
+// Wait polls operation with exponential backoff (see SetPollPolicy) until it is done.
+// If the operation fails, an *errors.OperationError is returned. If response is not nil,
+// the operation response (usually the created or updated resource) is unmarshaled into it.
+func (r *OperationResource) Wait(ctx context.Context, operationID string, response interface{}) (*models.Operation, error) {
+	interval := r.pollPolicy.InitialInterval
+
+	for {
+		operation, err := r.Get(ctx, operationID)
+		if err != nil {
+			return nil, err
+		}
+
+		if operation.Done {
+			return operation, decodeOperationResult(operation, response)
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		interval = time.Duration(float64(interval) * r.pollPolicy.Multiplier)
+		if r.pollPolicy.MaxInterval > 0 && interval > r.pollPolicy.MaxInterval {
+			interval = r.pollPolicy.MaxInterval
+		}
+	}
+}
+
+// decodeOperationResult converts operation error status to error and unmarshals operation response
+func decodeOperationResult(operation *models.Operation, response interface{}) error {
+	if operation.Error != nil {
+		return errors.NewOperationError(
+			operation.ID,
+			operation.Error.Message,
+			errors.GRPCCode(operation.Error.Code),
+			operation.Error.Details,
+		)
+	}
+
+	if response == nil || len(operation.Response) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(operation.Response, response); err != nil {
+		return errors.NewAPIError("Failed to parse operation response", 0, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package resources_test
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+	"time"
+
+	"github.com/tigusigalpa/yandex-cloud-client-go/errors"
+	"github.com/tigusigalpa/yandex-cloud-client-go/models"
+	"github.com/tigusigalpa/yandex-cloud-client-go/yandexcloudtest"
+)
+
+func TestOperationWaitUsesPollPolicy(t *testing.T) {
+	client, server := yandexcloudtest.NewClient(t)
+	server.PendingPolls = 3
+	cloud := server.AddCloud(models.Cloud{Name: "cloud"})
+	ctx := context.Background()
+
+	op, err := client.Folders().Create(ctx, cloud.ID, "folder", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if op.Done {
+		t.Fatal("operation is done before polling")
+	}
+
+	start := time.Now()
+	var folder models.Folder
+	op, err = client.Operations().Wait(ctx, op.ID, &folder)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !op.Done || folder.Name != "folder" || folder.CloudID != cloud.ID {
+		t.Fatalf("Wait returned done=%v folder=%+v", op.Done, folder)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("Wait took %s with a %s poll interval", elapsed, yandexcloudtest.PollInterval)
+	}
+}
+
+func TestOperationWaitReturnsOperationError(t *testing.T) {
+	client, server := yandexcloudtest.NewClient(t)
+	server.PendingPolls = 5
+	cloud := server.AddCloud(models.Cloud{Name: "cloud"})
+	ctx := context.Background()
+
+	op, err := client.Folders().Create(ctx, cloud.ID, "folder", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Operations().Cancel(ctx, op.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.Operations().Wait(ctx, op.ID, nil)
+	if errors.CodeOf(err) != errors.CodeCanceled {
+		t.Fatalf("Wait error = %v, want CANCELLED operation error", err)
+	}
+}
+
+func TestOperationWaitStopsOnContextCancel(t *testing.T) {
+	client, server := yandexcloudtest.NewClient(t)
+	server.PendingPolls = 1 << 30
+	cloud := server.AddCloud(models.Cloud{Name: "cloud"})
+
+	op, err := client.Folders().Create(context.Background(), cloud.ID, "folder", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := client.Operations().Wait(ctx, op.ID, nil); !stderrors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Wait error = %v, want context.DeadlineExceeded", err)
+	}
+}
@@ -1,10 +1,12 @@
 package resources
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/tigusigalpa/yandex-cloud-client-go/auth"
 	"github.com/tigusigalpa/yandex-cloud-client-go/errors"
+	"github.com/tigusigalpa/yandex-cloud-client-go/models"
 )
 
 // OrganizationResource handles organization-related operations
@@ -13,14 +15,16 @@ type OrganizationResource struct {
 }
 
 // NewOrganizationResource creates a new organization resource
-func NewOrganizationResource(httpClient *http.Client, authManager *auth.IAMTokenManager, baseURI string) *OrganizationResource {
+func NewOrganizationResource(httpClient *http.Client, credentials auth.Credentials, baseURI string) *OrganizationResource {
 	return &OrganizationResource{
-		AbstractResource: NewAbstractResource(httpClient, authManager, baseURI),
+		AbstractResource: newAbstractResource(httpClient, credentials, baseURI, "organizationmanager", "Organizations"),
 	}
 }
 
 // List gets list of organizations
-func (r *OrganizationResource) List(pageSize *int, pageToken *string) (map[string]interface{}, error) {
+func (r *OrganizationResource) List(ctx context.Context, pageSize *int, pageToken *string) (*models.ListOrganizationsResponse, error) {
+	ctx = r.call(ctx, "List")
+
 	params := make(map[string]interface{})
 	if pageSize != nil {
 		params["pageSize"] = *pageSize
@@ -30,20 +34,43 @@ func (r *OrganizationResource) List(pageSize *int, pageToken *string) (map[strin
 	}
 
 	query := r.BuildQueryString(params)
-	return r.MakeRequest("GET", "organization-manager/v1/organizations"+query, nil)
+	var response models.ListOrganizationsResponse
+	if err := r.MakeRequestInto(ctx, "GET", "organization-manager/v1/organizations"+query, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// ListAll returns a pager over all organizations
+func (r *OrganizationResource) ListAll(ctx context.Context, pageSize *int) *Pager[models.Organization] {
+	return newPager(ctx, func(ctx context.Context, pageToken *string) ([]models.Organization, string, error) {
+		response, err := r.List(ctx, pageSize, pageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		return response.Organizations, response.NextPageToken, nil
+	})
 }
 
 // Get gets organization details
-func (r *OrganizationResource) Get(organizationID string) (map[string]interface{}, error) {
+func (r *OrganizationResource) Get(ctx context.Context, organizationID string) (*models.Organization, error) {
+	ctx = r.call(ctx, "Get")
+
 	if organizationID == "" {
 		return nil, errors.NewValidationError("Organization ID cannot be empty")
 	}
 
-	return r.MakeRequest("GET", "organization-manager/v1/organizations/"+organizationID, nil)
+	var organization models.Organization
+	if err := r.MakeRequestInto(ctx, "GET", "organization-manager/v1/organizations/"+organizationID, nil, &organization); err != nil {
+		return nil, err
+	}
+	return &organization, nil
 }
 
 // Update updates organization
-func (r *OrganizationResource) Update(organizationID string, data map[string]interface{}) (map[string]interface{}, error) {
+func (r *OrganizationResource) Update(ctx context.Context, organizationID string, data map[string]interface{}) (*models.Operation, error) {
+	ctx = r.call(ctx, "Update")
+
 	if organizationID == "" {
 		return nil, errors.NewValidationError("Organization ID cannot be empty")
 	}
@@ -52,11 +79,13 @@ func (r *OrganizationResource) Update(organizationID string, data map[string]int
 		return nil, errors.NewValidationError("Update data cannot be empty")
 	}
 
-	return r.MakeRequest("PATCH", "organization-manager/v1/organizations/"+organizationID, data)
+	return r.makeOperationRequest(ctx, "PATCH", "organization-manager/v1/organizations/"+organizationID, data)
 }
 
 // ListAccessBindings lists access bindings for organization
-func (r *OrganizationResource) ListAccessBindings(organizationID string, pageSize *int, pageToken *string) (map[string]interface{}, error) {
+func (r *OrganizationResource) ListAccessBindings(ctx context.Context, organizationID string, pageSize *int, pageToken *string) (*models.ListAccessBindingsResponse, error) {
+	ctx = r.call(ctx, "ListAccessBindings")
+
 	if organizationID == "" {
 		return nil, errors.NewValidationError("Organization ID cannot be empty")
 	}
@@ -70,11 +99,28 @@ func (r *OrganizationResource) ListAccessBindings(organizationID string, pageSiz
 	}
 
 	query := r.BuildQueryString(params)
-	return r.MakeRequest("GET", "organization-manager/v1/organizations/"+organizationID+":listAccessBindings"+query, nil)
+	var response models.ListAccessBindingsResponse
+	if err := r.MakeRequestInto(ctx, "GET", "organization-manager/v1/organizations/"+organizationID+":listAccessBindings"+query, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// ListAllAccessBindings returns a pager over all access bindings for organization
+func (r *OrganizationResource) ListAllAccessBindings(ctx context.Context, organizationID string, pageSize *int) *Pager[models.AccessBinding] {
+	return newPager(ctx, func(ctx context.Context, pageToken *string) ([]models.AccessBinding, string, error) {
+		response, err := r.ListAccessBindings(ctx, organizationID, pageSize, pageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		return response.AccessBindings, response.NextPageToken, nil
+	})
 }
 
 // UpdateAccessBindings updates access bindings for organization
-func (r *OrganizationResource) UpdateAccessBindings(organizationID string, accessBindingDeltas []map[string]interface{}) (map[string]interface{}, error) {
+func (r *OrganizationResource) UpdateAccessBindings(ctx context.Context, organizationID string, accessBindingDeltas []map[string]interface{}) (*models.Operation, error) {
+	ctx = r.call(ctx, "UpdateAccessBindings")
+
 	if organizationID == "" {
 		return nil, errors.NewValidationError("Organization ID cannot be empty")
 	}
@@ -87,11 +133,11 @@ func (r *OrganizationResource) UpdateAccessBindings(organizationID string, acces
 		"accessBindingDeltas": accessBindingDeltas,
 	}
 
-	return r.MakeRequest("POST", "organization-manager/v1/organizations/"+organizationID+":updateAccessBindings", body)
+	return r.makeOperationRequest(ctx, "POST", "organization-manager/v1/organizations/"+organizationID+":updateAccessBindings", body)
 }
 
 // AddRole adds a role to organization (helper method)
-func (r *OrganizationResource) AddRole(organizationID, subjectID, roleID, subjectType string) (map[string]interface{}, error) {
+func (r *OrganizationResource) AddRole(ctx context.Context, organizationID, subjectID, roleID, subjectType string) (*models.Operation, error) {
 	if subjectType == "" {
 		subjectType = "userAccount"
 	}
@@ -109,11 +155,11 @@ func (r *OrganizationResource) AddRole(organizationID, subjectID, roleID, subjec
 		},
 	}
 
-	return r.UpdateAccessBindings(organizationID, deltas)
+	return r.UpdateAccessBindings(ctx, organizationID, deltas)
 }
 
 // RemoveRole removes a role from organization (helper method)
-func (r *OrganizationResource) RemoveRole(organizationID, subjectID, roleID, subjectType string) (map[string]interface{}, error) {
+func (r *OrganizationResource) RemoveRole(ctx context.Context, organizationID, subjectID, roleID, subjectType string) (*models.Operation, error) {
 	if subjectType == "" {
 		subjectType = "userAccount"
 	}
@@ -131,5 +177,5 @@ func (r *OrganizationResource) RemoveRole(organizationID, subjectID, roleID, sub
 		},
 	}
 
-	return r.UpdateAccessBindings(organizationID, deltas)
+	return r.UpdateAccessBindings(ctx, organizationID, deltas)
 }
@@ -0,0 +1,106 @@
+package resources
+
+import (
+	"context"
+)
+
+// pageFetcher fetches a single page of a list and returns its items and the next page token
+type pageFetcher[T any] func(ctx context.Context, pageToken *string) ([]T, string, error)
+
+// Pager iterates over all items of a paginated list, transparently following page tokens.
+// Iteration stops on the first error or when the context is cancelled.
+//
+//	pager := client.Folders().ListAll(ctx, cloudID, nil)
+//	for pager.Next() {
+//		folder := pager.Item()
+//		// ...
+//	}
+//	if err := pager.Err(); err != nil {
+//		// ...
+//	}
+type Pager[T any] struct {
+	ctx       context.Context
+	fetch     pageFetcher[T]
+	items     []T
+	index     int
+	pageToken string
+	started   bool
+	err       error
+}
+
+// newPager creates a new pager
+func newPager[T any](ctx context.Context, fetch pageFetcher[T]) *Pager[T] {
+	return &Pager[T]{
+		ctx:   ctx,
+		fetch: fetch,
+		index: -1,
+	}
+}
+
+// NewSlicePager creates a pager over a fixed list of items, e.g. to stub ListAll methods in tests
+func NewSlicePager[T any](ctx context.Context, items []T) *Pager[T] {
+	return newPager(ctx, func(ctx context.Context, pageToken *string) ([]T, string, error) {
+		return items, "", nil
+	})
+}
+
+// Next advances the pager to the next item, fetching the next page when needed.
+// It returns false when there are no more items or an error occurred.
+func (p *Pager[T]) Next() bool {
+	if p.err != nil {
+		return false
+	}
+
+	if err := p.ctx.Err(); err != nil {
+		p.err = err
+		return false
+	}
+
+	for p.index+1 >= len(p.items) {
+		if p.started && p.pageToken == "" {
+			return false
+		}
+
+		var pageToken *string
+		if p.pageToken != "" {
+			pageToken = &p.pageToken
+		}
+
+		items, nextPageToken, err := p.fetch(p.ctx, pageToken)
+		if err != nil {
+			p.err = err
+			return false
+		}
+
+		p.started = true
+		p.items = items
+		p.index = -1
+		p.pageToken = nextPageToken
+	}
+
+	p.index++
+	return true
+}
+
+// Item returns the current item
+func (p *Pager[T]) Item() T {
+	var zero T
+	if p.index < 0 || p.index >= len(p.items) {
+		return zero
+	}
+	return p.items[p.index]
+}
+
+// Err returns the error that stopped iteration, if any
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// All collects all remaining items
+func (p *Pager[T]) All() ([]T, error) {
+	var items []T
+	for p.Next() {
+		items = append(items, p.Item())
+	}
+	return items, p.Err()
+}
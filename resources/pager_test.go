@@ -0,0 +1,125 @@
+package resources
+
+import (
+	"context"
+	stderrors "errors"
+	"reflect"
+	"testing"
+)
+
+// page is a page served by pagesFetcher
+type page struct {
+	items         []int
+	nextPageToken string
+	err           error
+}
+
+// pagesFetcher serves pages in order and records the requested page tokens
+func pagesFetcher(pages []page, tokens *[]string) pageFetcher[int] {
+	i := 0
+	return func(ctx context.Context, pageToken *string) ([]int, string, error) {
+		token := ""
+		if pageToken != nil {
+			token = *pageToken
+		}
+		*tokens = append(*tokens, token)
+
+		p := pages[i]
+		i++
+		return p.items, p.nextPageToken, p.err
+	}
+}
+
+func TestPagerFollowsPageTokens(t *testing.T) {
+	var tokens []string
+	pager := newPager(context.Background(), pagesFetcher([]page{
+		{items: []int{1, 2}, nextPageToken: "p2"},
+		{items: nil, nextPageToken: "p3"}, // an empty page may still have more pages after it
+		{items: []int{3}},
+	}, &tokens))
+
+	items, err := pager.All()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(items, []int{1, 2, 3}) {
+		t.Errorf("items = %v, want [1 2 3]", items)
+	}
+	if !reflect.DeepEqual(tokens, []string{"", "p2", "p3"}) {
+		t.Errorf("page tokens = %q, want [\"\" p2 p3]", tokens)
+	}
+
+	if pager.Next() {
+		t.Error("Next after the last page returned true")
+	}
+}
+
+func TestPagerErrorSticks(t *testing.T) {
+	fetchErr := stderrors.New("unavailable")
+	var tokens []string
+	pager := newPager(context.Background(), pagesFetcher([]page{
+		{items: []int{1}, nextPageToken: "p2"},
+		{err: fetchErr},
+		{items: []int{2}},
+	}, &tokens))
+
+	items, err := pager.All()
+	if err != fetchErr {
+		t.Fatalf("All error = %v, want %v", err, fetchErr)
+	}
+	if !reflect.DeepEqual(items, []int{1}) {
+		t.Errorf("All items = %v, want the items before the error", items)
+	}
+
+	for i := 0; i < 2; i++ {
+		if pager.Next() || pager.Err() != fetchErr {
+			t.Fatalf("Next after an error: Err = %v", pager.Err())
+		}
+	}
+	if len(tokens) != 2 {
+		t.Errorf("fetched %d pages, want no fetch after the error", len(tokens))
+	}
+}
+
+func TestPagerStopsOnContextCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var tokens []string
+	pager := newPager(ctx, pagesFetcher([]page{
+		{items: []int{1, 2}, nextPageToken: "p2"},
+		{items: []int{3}},
+	}, &tokens))
+
+	if !pager.Next() || pager.Item() != 1 {
+		t.Fatal("first item not returned")
+	}
+	cancel()
+
+	if pager.Next() {
+		t.Error("Next returned true after cancellation")
+	}
+	if pager.Err() != context.Canceled {
+		t.Errorf("Err = %v, want %v", pager.Err(), context.Canceled)
+	}
+	if len(tokens) != 1 {
+		t.Errorf("fetched %d pages, want 1", len(tokens))
+	}
+}
+
+func TestPagerItemBeforeNext(t *testing.T) {
+	pager := NewSlicePager(context.Background(), []int{1})
+	if item := pager.Item(); item != 0 {
+		t.Errorf("Item before Next = %d, want zero value", item)
+	}
+}
+
+func TestSlicePager(t *testing.T) {
+	items, err := NewSlicePager(context.Background(), []string{"a", "b"}).All()
+	if err != nil || !reflect.DeepEqual(items, []string{"a", "b"}) {
+		t.Errorf("All = %v, %v; want [a b]", items, err)
+	}
+
+	items, err = NewSlicePager[string](context.Background(), nil).All()
+	if err != nil || len(items) != 0 {
+		t.Errorf("empty All = %v, %v", items, err)
+	}
+}
@@ -1,10 +1,12 @@
 package resources
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/tigusigalpa/yandex-cloud-client-go/auth"
 	"github.com/tigusigalpa/yandex-cloud-client-go/errors"
+	"github.com/tigusigalpa/yandex-cloud-client-go/models"
 )
 
 // RefreshTokenResource handles refresh token-related operations
@@ -13,14 +15,16 @@ type RefreshTokenResource struct {
 }
 
 // NewRefreshTokenResource creates a new refresh token resource
-func NewRefreshTokenResource(httpClient *http.Client, authManager *auth.IAMTokenManager, baseURI string) *RefreshTokenResource {
+func NewRefreshTokenResource(httpClient *http.Client, credentials auth.Credentials, baseURI string) *RefreshTokenResource {
 	return &RefreshTokenResource{
-		AbstractResource: NewAbstractResource(httpClient, authManager, baseURI),
+		AbstractResource: newAbstractResource(httpClient, credentials, baseURI, "iam", "RefreshTokens"),
 	}
 }
 
 // List gets list of refresh tokens
-func (r *RefreshTokenResource) List(pageSize *int, pageToken *string) (map[string]interface{}, error) {
+func (r *RefreshTokenResource) List(ctx context.Context, pageSize *int, pageToken *string) (*models.ListRefreshTokensResponse, error) {
+	ctx = r.call(ctx, "List")
+
 	params := make(map[string]interface{})
 	if pageSize != nil {
 		params["pageSize"] = *pageSize
@@ -30,14 +34,31 @@ func (r *RefreshTokenResource) List(pageSize *int, pageToken *string) (map[strin
 	}
 
 	query := r.BuildQueryString(params)
-	return r.MakeRequest("GET", "iam/v1/refreshTokens"+query, nil)
+	var response models.ListRefreshTokensResponse
+	if err := r.MakeRequestInto(ctx, "GET", "iam/v1/refreshTokens"+query, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// ListAll returns a pager over all refresh tokens
+func (r *RefreshTokenResource) ListAll(ctx context.Context, pageSize *int) *Pager[models.RefreshToken] {
+	return newPager(ctx, func(ctx context.Context, pageToken *string) ([]models.RefreshToken, string, error) {
+		response, err := r.List(ctx, pageSize, pageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		return response.RefreshTokens, response.NextPageToken, nil
+	})
 }
 
 // Revoke revokes a refresh token
-func (r *RefreshTokenResource) Revoke(tokenID string) (map[string]interface{}, error) {
+func (r *RefreshTokenResource) Revoke(ctx context.Context, tokenID string) (*models.Operation, error) {
+	ctx = r.call(ctx, "Revoke")
+
 	if tokenID == "" {
 		return nil, errors.NewValidationError("Token ID cannot be empty")
 	}
 
-	return r.MakeRequest("DELETE", "iam/v1/refreshTokens/"+tokenID, nil)
+	return r.makeOperationRequest(ctx, "DELETE", "iam/v1/refreshTokens/"+tokenID, nil)
 }
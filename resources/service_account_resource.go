@@ -1,10 +1,12 @@
 package resources
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/tigusigalpa/yandex-cloud-client-go/auth"
 	"github.com/tigusigalpa/yandex-cloud-client-go/errors"
+	"github.com/tigusigalpa/yandex-cloud-client-go/models"
 )
 
 // ServiceAccountResource handles service account-related operations
@@ -13,14 +15,16 @@ type ServiceAccountResource struct {
 }
 
 // NewServiceAccountResource creates a new service account resource
-func NewServiceAccountResource(httpClient *http.Client, authManager *auth.IAMTokenManager, baseURI string) *ServiceAccountResource {
+func NewServiceAccountResource(httpClient *http.Client, credentials auth.Credentials, baseURI string) *ServiceAccountResource {
 	return &ServiceAccountResource{
-		AbstractResource: NewAbstractResource(httpClient, authManager, baseURI),
+		AbstractResource: newAbstractResource(httpClient, credentials, baseURI, "iam", "ServiceAccounts"),
 	}
 }
 
 // List gets list of service accounts in folder
-func (r *ServiceAccountResource) List(folderID string, pageSize *int, pageToken *string) (map[string]interface{}, error) {
+func (r *ServiceAccountResource) List(ctx context.Context, folderID string, pageSize *int, pageToken *string) (*models.ListServiceAccountsResponse, error) {
+	ctx = r.call(ctx, "List")
+
 	params := make(map[string]interface{})
 	params["folderId"] = folderID
 
@@ -32,20 +36,43 @@ func (r *ServiceAccountResource) List(folderID string, pageSize *int, pageToken
 	}
 
 	query := r.BuildQueryString(params)
-	return r.MakeRequest("GET", "iam/v1/serviceAccounts"+query, nil)
+	var response models.ListServiceAccountsResponse
+	if err := r.MakeRequestInto(ctx, "GET", "iam/v1/serviceAccounts"+query, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// ListAll returns a pager over all service accounts in folder
+func (r *ServiceAccountResource) ListAll(ctx context.Context, folderID string, pageSize *int) *Pager[models.ServiceAccount] {
+	return newPager(ctx, func(ctx context.Context, pageToken *string) ([]models.ServiceAccount, string, error) {
+		response, err := r.List(ctx, folderID, pageSize, pageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		return response.ServiceAccounts, response.NextPageToken, nil
+	})
 }
 
 // Get gets service account details
-func (r *ServiceAccountResource) Get(serviceAccountID string) (map[string]interface{}, error) {
+func (r *ServiceAccountResource) Get(ctx context.Context, serviceAccountID string) (*models.ServiceAccount, error) {
+	ctx = r.call(ctx, "Get")
+
 	if serviceAccountID == "" {
 		return nil, errors.NewValidationError("Service account ID cannot be empty")
 	}
 
-	return r.MakeRequest("GET", "iam/v1/serviceAccounts/"+serviceAccountID, nil)
+	var serviceAccount models.ServiceAccount
+	if err := r.MakeRequestInto(ctx, "GET", "iam/v1/serviceAccounts/"+serviceAccountID, nil, &serviceAccount); err != nil {
+		return nil, err
+	}
+	return &serviceAccount, nil
 }
 
 // Create creates a new service account
-func (r *ServiceAccountResource) Create(folderID, name string, description *string) (map[string]interface{}, error) {
+func (r *ServiceAccountResource) Create(ctx context.Context, folderID, name string, description *string) (*models.Operation, error) {
+	ctx = r.call(ctx, "Create")
+
 	if folderID == "" {
 		return nil, errors.NewValidationError("Folder ID cannot be empty")
 	}
@@ -63,11 +90,13 @@ func (r *ServiceAccountResource) Create(folderID, name string, description *stri
 		data["description"] = *description
 	}
 
-	return r.MakeRequest("POST", "iam/v1/serviceAccounts", data)
+	return r.makeOperationRequest(ctx, "POST", "iam/v1/serviceAccounts", data)
 }
 
 // Update updates service account
-func (r *ServiceAccountResource) Update(serviceAccountID string, data map[string]interface{}) (map[string]interface{}, error) {
+func (r *ServiceAccountResource) Update(ctx context.Context, serviceAccountID string, data map[string]interface{}) (*models.Operation, error) {
+	ctx = r.call(ctx, "Update")
+
 	if serviceAccountID == "" {
 		return nil, errors.NewValidationError("Service account ID cannot be empty")
 	}
@@ -76,20 +105,24 @@ func (r *ServiceAccountResource) Update(serviceAccountID string, data map[string
 		return nil, errors.NewValidationError("Update data cannot be empty")
 	}
 
-	return r.MakeRequest("PATCH", "iam/v1/serviceAccounts/"+serviceAccountID, data)
+	return r.makeOperationRequest(ctx, "PATCH", "iam/v1/serviceAccounts/"+serviceAccountID, data)
 }
 
 // Delete deletes service account
-func (r *ServiceAccountResource) Delete(serviceAccountID string) (map[string]interface{}, error) {
+func (r *ServiceAccountResource) Delete(ctx context.Context, serviceAccountID string) (*models.Operation, error) {
+	ctx = r.call(ctx, "Delete")
+
 	if serviceAccountID == "" {
 		return nil, errors.NewValidationError("Service account ID cannot be empty")
 	}
 
-	return r.MakeRequest("DELETE", "iam/v1/serviceAccounts/"+serviceAccountID, nil)
+	return r.makeOperationRequest(ctx, "DELETE", "iam/v1/serviceAccounts/"+serviceAccountID, nil)
 }
 
 // ListAccessBindings lists access bindings for service account
-func (r *ServiceAccountResource) ListAccessBindings(serviceAccountID string, pageSize *int, pageToken *string) (map[string]interface{}, error) {
+func (r *ServiceAccountResource) ListAccessBindings(ctx context.Context, serviceAccountID string, pageSize *int, pageToken *string) (*models.ListAccessBindingsResponse, error) {
+	ctx = r.call(ctx, "ListAccessBindings")
+
 	if serviceAccountID == "" {
 		return nil, errors.NewValidationError("Service account ID cannot be empty")
 	}
@@ -103,11 +136,28 @@ func (r *ServiceAccountResource) ListAccessBindings(serviceAccountID string, pag
 	}
 
 	query := r.BuildQueryString(params)
-	return r.MakeRequest("GET", "iam/v1/serviceAccounts/"+serviceAccountID+":listAccessBindings"+query, nil)
+	var response models.ListAccessBindingsResponse
+	if err := r.MakeRequestInto(ctx, "GET", "iam/v1/serviceAccounts/"+serviceAccountID+":listAccessBindings"+query, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// ListAllAccessBindings returns a pager over all access bindings for service account
+func (r *ServiceAccountResource) ListAllAccessBindings(ctx context.Context, serviceAccountID string, pageSize *int) *Pager[models.AccessBinding] {
+	return newPager(ctx, func(ctx context.Context, pageToken *string) ([]models.AccessBinding, string, error) {
+		response, err := r.ListAccessBindings(ctx, serviceAccountID, pageSize, pageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		return response.AccessBindings, response.NextPageToken, nil
+	})
 }
 
 // UpdateAccessBindings updates access bindings for service account
-func (r *ServiceAccountResource) UpdateAccessBindings(serviceAccountID string, accessBindingDeltas []map[string]interface{}) (map[string]interface{}, error) {
+func (r *ServiceAccountResource) UpdateAccessBindings(ctx context.Context, serviceAccountID string, accessBindingDeltas []map[string]interface{}) (*models.Operation, error) {
+	ctx = r.call(ctx, "UpdateAccessBindings")
+
 	if serviceAccountID == "" {
 		return nil, errors.NewValidationError("Service account ID cannot be empty")
 	}
@@ -120,11 +170,11 @@ func (r *ServiceAccountResource) UpdateAccessBindings(serviceAccountID string, a
 		"accessBindingDeltas": accessBindingDeltas,
 	}
 
-	return r.MakeRequest("POST", "iam/v1/serviceAccounts/"+serviceAccountID+":updateAccessBindings", body)
+	return r.makeOperationRequest(ctx, "POST", "iam/v1/serviceAccounts/"+serviceAccountID+":updateAccessBindings", body)
 }
 
 // AddRole adds a role to service account (helper method)
-func (r *ServiceAccountResource) AddRole(serviceAccountID, subjectID, roleID, subjectType string) (map[string]interface{}, error) {
+func (r *ServiceAccountResource) AddRole(ctx context.Context, serviceAccountID, subjectID, roleID, subjectType string) (*models.Operation, error) {
 	if subjectType == "" {
 		subjectType = "userAccount"
 	}
@@ -142,11 +192,11 @@ func (r *ServiceAccountResource) AddRole(serviceAccountID, subjectID, roleID, su
 		},
 	}
 
-	return r.UpdateAccessBindings(serviceAccountID, deltas)
+	return r.UpdateAccessBindings(ctx, serviceAccountID, deltas)
 }
 
 // RemoveRole removes a role from service account (helper method)
-func (r *ServiceAccountResource) RemoveRole(serviceAccountID, subjectID, roleID, subjectType string) (map[string]interface{}, error) {
+func (r *ServiceAccountResource) RemoveRole(ctx context.Context, serviceAccountID, subjectID, roleID, subjectType string) (*models.Operation, error) {
 	if subjectType == "" {
 		subjectType = "userAccount"
 	}
@@ -164,5 +214,5 @@ func (r *ServiceAccountResource) RemoveRole(serviceAccountID, subjectID, roleID,
 		},
 	}
 
-	return r.UpdateAccessBindings(serviceAccountID, deltas)
+	return r.UpdateAccessBindings(ctx, serviceAccountID, deltas)
 }
@@ -1,10 +1,12 @@
 package resources
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/tigusigalpa/yandex-cloud-client-go/auth"
 	"github.com/tigusigalpa/yandex-cloud-client-go/errors"
+	"github.com/tigusigalpa/yandex-cloud-client-go/models"
 )
 
 // UserAccountResource handles user account-related operations
@@ -13,17 +15,23 @@ type UserAccountResource struct {
 }
 
 // NewUserAccountResource creates a new user account resource
-func NewUserAccountResource(httpClient *http.Client, authManager *auth.IAMTokenManager, baseURI string) *UserAccountResource {
+func NewUserAccountResource(httpClient *http.Client, credentials auth.Credentials, baseURI string) *UserAccountResource {
 	return &UserAccountResource{
-		AbstractResource: NewAbstractResource(httpClient, authManager, baseURI),
+		AbstractResource: newAbstractResource(httpClient, credentials, baseURI, "iam", "UserAccounts"),
 	}
 }
 
 // Get gets user account details by ID
-func (r *UserAccountResource) Get(userAccountID string) (map[string]interface{}, error) {
+func (r *UserAccountResource) Get(ctx context.Context, userAccountID string) (*models.UserAccount, error) {
+	ctx = r.call(ctx, "Get")
+
 	if userAccountID == "" {
 		return nil, errors.NewValidationError("User account ID cannot be empty")
 	}
 
-	return r.MakeRequest("GET", "iam/v1/userAccounts/"+userAccountID, nil)
+	var userAccount models.UserAccount
+	if err := r.MakeRequestInto(ctx, "GET", "iam/v1/userAccounts/"+userAccountID, nil, &userAccount); err != nil {
+		return nil, err
+	}
+	return &userAccount, nil
 }
@@ -1,10 +1,12 @@
 package resources
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/tigusigalpa/yandex-cloud-client-go/auth"
 	"github.com/tigusigalpa/yandex-cloud-client-go/errors"
+	"github.com/tigusigalpa/yandex-cloud-client-go/models"
 )
 
 // YandexPassportUserAccountResource handles Yandex Passport user account-related operations
@@ -13,17 +15,23 @@ type YandexPassportUserAccountResource struct {
 }
 
 // NewYandexPassportUserAccountResource creates a new Yandex Passport user account resource
-func NewYandexPassportUserAccountResource(httpClient *http.Client, authManager *auth.IAMTokenManager, baseURI string) *YandexPassportUserAccountResource {
+func NewYandexPassportUserAccountResource(httpClient *http.Client, credentials auth.Credentials, baseURI string) *YandexPassportUserAccountResource {
 	return &YandexPassportUserAccountResource{
-		AbstractResource: NewAbstractResource(httpClient, authManager, baseURI),
+		AbstractResource: newAbstractResource(httpClient, credentials, baseURI, "iam", "YandexPassportUserAccounts"),
 	}
 }
 
 // GetByLogin gets user account by Yandex Passport login
-func (r *YandexPassportUserAccountResource) GetByLogin(login string) (map[string]interface{}, error) {
+func (r *YandexPassportUserAccountResource) GetByLogin(ctx context.Context, login string) (*models.UserAccount, error) {
+	ctx = r.call(ctx, "GetByLogin")
+
 	if login == "" {
 		return nil, errors.NewValidationError("Login cannot be empty")
 	}
 
-	return r.MakeRequest("GET", "iam/v1/yandexPassportUserAccounts:byLogin?login="+login, nil)
+	var userAccount models.UserAccount
+	if err := r.MakeRequestInto(ctx, "GET", "iam/v1/yandexPassportUserAccounts:byLogin?login="+login, nil, &userAccount); err != nil {
+		return nil, err
+	}
+	return &userAccount, nil
 }
@@ -0,0 +1,153 @@
+// Package tracing provides OpenTelemetry tracing for Yandex Cloud API calls
+package tracing
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/tigusigalpa/yandex-cloud-client-go/errors"
+	"github.com/tigusigalpa/yandex-cloud-client-go/transport"
+)
+
+// instrumentationName is the name of the tracer
+const instrumentationName = "github.com/tigusigalpa/yandex-cloud-client-go/tracing"
+
+// Span attribute keys
+const (
+	RequestIDKey       = attribute.Key("yandexcloud.request_id")
+	ClientRequestIDKey = attribute.Key("yandexcloud.client_request_id")
+	TokenExchangeKey   = attribute.Key("yandexcloud.token_exchange")
+)
+
+// Option configures tracing
+type Option func(*config)
+
+// config holds tracing configuration
+type config struct {
+	tracerProvider trace.TracerProvider
+	propagators    propagation.TextMapPropagator
+}
+
+// WithTracerProvider sets the tracer provider (the global one by default)
+func WithTracerProvider(tracerProvider trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = tracerProvider
+	}
+}
+
+// WithPropagators sets the propagators used to inject trace context into request headers
+// (the global ones by default)
+func WithPropagators(propagators propagation.TextMapPropagator) Option {
+	return func(c *config) {
+		c.propagators = propagators
+	}
+}
+
+// Middleware returns a client middleware that creates a span per API call and IAM token exchange,
+// named after the call (e.g. "resourcemanager.Folders.List"), and propagates trace context in headers.
+//
+//	client, err := yandexcloud.NewClientWithOptions(
+//		yandexcloud.WithOAuthToken(oauthToken),
+//		yandexcloud.WithMiddleware(tracing.Middleware()),
+//	)
+func Middleware(opts ...Option) transport.Middleware {
+	cfg := &config{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return func(next transport.Handler) transport.Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			tracerProvider := cfg.tracerProvider
+			if tracerProvider == nil {
+				tracerProvider = otel.GetTracerProvider()
+			}
+			propagators := cfg.propagators
+			if propagators == nil {
+				propagators = otel.GetTextMapPropagator()
+			}
+
+			info, _ := transport.CallInfoFromContext(req.Context())
+
+			startOpts := []trace.SpanStartOption{
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(requestAttributes(req, info)...),
+			}
+			// Background work such as a shared token refresh is linked to the call that triggered it
+			if linked, ok := transport.LinkedContextFromContext(req.Context()); ok {
+				if link := trace.SpanContextFromContext(linked); link.IsValid() {
+					startOpts = append(startOpts, trace.WithLinks(trace.Link{SpanContext: link}))
+				}
+			}
+
+			ctx, span := tracerProvider.Tracer(instrumentationName).Start(req.Context(), spanName(req, info), startOpts...)
+			defer span.End()
+
+			req = req.Clone(ctx)
+			propagators.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+			resp, err := next(req)
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+				return resp, err
+			}
+
+			span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+			if requestID := resp.Header.Get(transport.RequestIDHeader); requestID != "" {
+				span.SetAttributes(RequestIDKey.String(requestID))
+			}
+
+			if resp.StatusCode >= 400 {
+				body, _ := transport.ReadResponseBody(resp)
+				apiErr := errors.NewAPIErrorFromHTTPResponse(resp, body)
+				span.SetAttributes(attribute.Int("rpc.grpc.status_code", int(apiErr.GRPCCode)))
+				span.SetStatus(codes.Error, apiErr.Error())
+			} else {
+				span.SetAttributes(attribute.Int("rpc.grpc.status_code", int(errors.CodeOK)))
+			}
+
+			return resp, nil
+		}
+	}
+}
+
+// spanName returns the span name of a request
+func spanName(req *http.Request, info transport.CallInfo) string {
+	if info.Service == "" {
+		return "HTTP " + req.Method
+	}
+	return info.Name()
+}
+
+// requestAttributes returns the span attributes known before sending the request
+func requestAttributes(req *http.Request, info transport.CallInfo) []attribute.KeyValue {
+	attributes := []attribute.KeyValue{
+		attribute.String("http.request.method", req.Method),
+		attribute.String("server.address", req.URL.Hostname()),
+		attribute.String("url.path", req.URL.Path),
+	}
+
+	if info.Service != "" {
+		attributes = append(attributes,
+			attribute.String("rpc.system", "yandexcloud"),
+			attribute.String("rpc.service", info.Service+"."+info.Resource),
+			attribute.String("rpc.method", info.Method),
+		)
+	}
+
+	if info.TokenExchange {
+		attributes = append(attributes, TokenExchangeKey.Bool(true))
+	}
+
+	if clientRequestID := req.Header.Get(transport.ClientRequestIDHeader); clientRequestID != "" {
+		attributes = append(attributes, ClientRequestIDKey.String(clientRequestID))
+	}
+
+	return attributes
+}
@@ -0,0 +1,203 @@
+package tracing_test
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"sync"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	yandexcloud "github.com/tigusigalpa/yandex-cloud-client-go"
+	"github.com/tigusigalpa/yandex-cloud-client-go/errors"
+	"github.com/tigusigalpa/yandex-cloud-client-go/models"
+	"github.com/tigusigalpa/yandex-cloud-client-go/tracing"
+	"github.com/tigusigalpa/yandex-cloud-client-go/transport"
+	"github.com/tigusigalpa/yandex-cloud-client-go/yandexcloudtest"
+)
+
+// tracedClient returns a fake server client traced into the returned span recorder.
+// An inner middleware records outgoing traceparent headers and adds a server request ID to responses.
+func tracedClient(t *testing.T, opts ...yandexcloud.Option) (*yandexcloud.Client, *yandexcloudtest.Server, *tracetest.SpanRecorder, *sdktrace.TracerProvider, func() []string) {
+	t.Helper()
+
+	recorder := tracetest.NewSpanRecorder()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	var (
+		mu           sync.Mutex
+		traceparents []string
+	)
+	server := func(next transport.Handler) transport.Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			mu.Lock()
+			traceparents = append(traceparents, req.Header.Get("traceparent"))
+			mu.Unlock()
+
+			resp, err := next(req)
+			if resp != nil {
+				resp.Header.Set(transport.RequestIDHeader, "server-request-id")
+			}
+			return resp, err
+		}
+	}
+
+	opts = append([]yandexcloud.Option{yandexcloud.WithMiddleware(
+		tracing.Middleware(
+			tracing.WithTracerProvider(tracerProvider),
+			tracing.WithPropagators(propagation.TraceContext{}),
+		),
+		server,
+	)}, opts...)
+	client, fake := yandexcloudtest.NewClient(t, opts...)
+
+	return client, fake, recorder, tracerProvider, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), traceparents...)
+	}
+}
+
+// spanByName returns the ended span with name
+func spanByName(t *testing.T, recorder *tracetest.SpanRecorder, name string) sdktrace.ReadOnlySpan {
+	t.Helper()
+
+	for _, span := range recorder.Ended() {
+		if span.Name() == name {
+			return span
+		}
+	}
+	t.Fatalf("no span %q", name)
+	return nil
+}
+
+// attributeValue returns the value of the span attribute with key
+func attributeValue(span sdktrace.ReadOnlySpan, key attribute.Key) (attribute.Value, bool) {
+	for _, kv := range span.Attributes() {
+		if kv.Key == key {
+			return kv.Value, true
+		}
+	}
+	return attribute.Value{}, false
+}
+
+func TestMiddlewareCreatesCallSpan(t *testing.T) {
+	client, server, recorder, _, traceparents := tracedClient(t)
+	cloud := server.AddCloud(models.Cloud{Name: "cloud"})
+
+	if _, err := client.Folders().List(context.Background(), cloud.ID, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	span := spanByName(t, recorder, "resourcemanager.Folders.List")
+	if span.SpanKind() != trace.SpanKindClient {
+		t.Errorf("span kind = %s, want client", span.SpanKind())
+	}
+	if span.Status().Code == codes.Error {
+		t.Errorf("span status = %+v", span.Status())
+	}
+
+	want := map[attribute.Key]attribute.Value{
+		"http.request.method":       attribute.StringValue("GET"),
+		"http.response.status_code": attribute.IntValue(http.StatusOK),
+		"rpc.grpc.status_code":      attribute.IntValue(int(errors.CodeOK)),
+		"rpc.service":               attribute.StringValue("resourcemanager.Folders"),
+		"rpc.method":                attribute.StringValue("List"),
+		tracing.RequestIDKey:        attribute.StringValue("server-request-id"),
+	}
+	for key, value := range want {
+		if got, ok := attributeValue(span, key); !ok || got != value {
+			t.Errorf("%s = %v, want %v", key, got.Emit(), value.Emit())
+		}
+	}
+	if _, ok := attributeValue(span, tracing.ClientRequestIDKey); !ok {
+		t.Errorf("%s is not set", tracing.ClientRequestIDKey)
+	}
+
+	// The last request is the API call; its traceparent carries the call span
+	headers := traceparents()
+	traceparent := headers[len(headers)-1]
+	wantTraceparent := "00-" + span.SpanContext().TraceID().String() + "-" + span.SpanContext().SpanID().String() + "-01"
+	if traceparent != wantTraceparent {
+		t.Errorf("traceparent = %q, want %q", traceparent, wantTraceparent)
+	}
+}
+
+func TestMiddlewareLinksTokenExchangeSpan(t *testing.T) {
+	client, server, recorder, tracerProvider, _ := tracedClient(t)
+	cloud := server.AddCloud(models.Cloud{Name: "cloud"})
+
+	ctx, parent := tracerProvider.Tracer("test").Start(context.Background(), "parent")
+	if _, err := client.Clouds().Get(ctx, cloud.ID); err != nil {
+		t.Fatal(err)
+	}
+	parent.End()
+
+	exchange := spanByName(t, recorder, "iam.IAMTokens.Create")
+	call := spanByName(t, recorder, "resourcemanager.Clouds.Get")
+
+	if call.Parent().SpanID() != parent.SpanContext().SpanID() {
+		t.Error("call span is not a child of the caller span")
+	}
+	if exchange.Parent().IsValid() || exchange.SpanContext().TraceID() == parent.SpanContext().TraceID() {
+		t.Error("token exchange span is parented on the caller span")
+	}
+
+	links := exchange.Links()
+	if len(links) != 1 || links[0].SpanContext.SpanID() != parent.SpanContext().SpanID() {
+		t.Errorf("token exchange links = %+v, want a link to the caller span", links)
+	}
+	if value, ok := attributeValue(exchange, tracing.TokenExchangeKey); !ok || !value.AsBool() {
+		t.Errorf("%s is not set", tracing.TokenExchangeKey)
+	}
+}
+
+func TestMiddlewareMarksFailedCalls(t *testing.T) {
+	client, _, recorder, _, _ := tracedClient(t)
+
+	if _, err := client.Folders().Get(context.Background(), "unknown"); err == nil {
+		t.Fatal("Get of an unknown folder succeeded")
+	}
+
+	span := spanByName(t, recorder, "resourcemanager.Folders.Get")
+	if span.Status().Code != codes.Error {
+		t.Errorf("span status = %+v, want error", span.Status())
+	}
+	if value, _ := attributeValue(span, "http.response.status_code"); value.AsInt64() != http.StatusNotFound {
+		t.Errorf("http.response.status_code = %d, want 404", value.AsInt64())
+	}
+	if value, _ := attributeValue(span, "rpc.grpc.status_code"); value.AsInt64() != int64(errors.CodeNotFound) {
+		t.Errorf("rpc.grpc.status_code = %d, want %d", value.AsInt64(), errors.CodeNotFound)
+	}
+}
+
+func TestMiddlewareRecordsTransportErrors(t *testing.T) {
+	failing := func(next transport.Handler) transport.Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			if info, ok := transport.CallInfoFromContext(req.Context()); ok && !info.TokenExchange {
+				return nil, stderrors.New("connection reset")
+			}
+			return next(req)
+		}
+	}
+	client, server, recorder, _, _ := tracedClient(t, yandexcloud.WithMiddleware(failing))
+	cloud := server.AddCloud(models.Cloud{Name: "cloud"})
+
+	if _, err := client.Clouds().Get(context.Background(), cloud.ID); err == nil {
+		t.Fatal("Get succeeded with a failing transport")
+	}
+
+	span := spanByName(t, recorder, "resourcemanager.Clouds.Get")
+	if span.Status().Code != codes.Error {
+		t.Errorf("span status = %+v, want error", span.Status())
+	}
+	if len(span.Events()) == 0 || span.Events()[0].Name != "exception" {
+		t.Errorf("span events = %+v, want a recorded error", span.Events())
+	}
+}
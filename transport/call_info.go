@@ -0,0 +1,31 @@
+package transport
+
+import "context"
+
+// CallInfo identifies the API call a request is made for (set by resources and token providers)
+type CallInfo struct {
+	// Service is the API service, e.g. "resourcemanager"
+	Service string
+	// Resource is the resource, e.g. "Folders"
+	Resource string
+	// Method is the resource method, e.g. "List"
+	Method string
+	// TokenExchange reports whether the request obtains an IAM token
+	TokenExchange bool
+}
+
+// Name returns the full call name, e.g. "resourcemanager.Folders.List"
+func (i CallInfo) Name() string {
+	return i.Service + "." + i.Resource + "." + i.Method
+}
+
+// WithCallInfo returns a context that makes requests carry info
+func WithCallInfo(ctx context.Context, info CallInfo) context.Context {
+	return context.WithValue(ctx, callInfoContextKey, info)
+}
+
+// CallInfoFromContext returns the call info stored in the context
+func CallInfoFromContext(ctx context.Context) (CallInfo, bool) {
+	info, ok := ctx.Value(callInfoContextKey).(CallInfo)
+	return info, ok
+}
@@ -0,0 +1,78 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+)
+
+type contextKey int
+
+const (
+	idempotencyKeyContextKey contextKey = iota
+	idempotentContextKey
+	callInfoContextKey
+	linkedContextKey
+)
+
+// IdempotencyKeyHeader is the header carrying the client idempotency key
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// idempotencyKey is an idempotency key that is used by a single request
+type idempotencyKey struct {
+	key  string
+	used atomic.Bool
+}
+
+// WithIdempotencyKey returns a context whose first mutating (non-GET/HEAD) API request carries
+// the Idempotency-Key header, with or without a retry policy. With a retry policy, requests with
+// an idempotency key are retried even if their HTTP method is not idempotent. The key is scoped to a single call: IAM token exchange never carries it,
+// and later requests made with the same context do not reuse it, so pass the context to one call only:
+//
+//	op, err := client.Folders().Create(transport.WithIdempotencyKey(ctx, "create-folder-42"), cloudID, "my-folder", nil, nil)
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyContextKey, &idempotencyKey{key: key})
+}
+
+// IdempotencyKeyFromContext returns the idempotency key stored in the context
+func IdempotencyKeyFromContext(ctx context.Context) (string, bool) {
+	key, ok := ctx.Value(idempotencyKeyContextKey).(*idempotencyKey)
+	if !ok || key.key == "" {
+		return "", false
+	}
+	return key.key, true
+}
+
+// claimIdempotencyKey returns the idempotency key for req and marks it as used.
+// Only the first mutating request that is not an IAM token exchange gets the key.
+func claimIdempotencyKey(req *http.Request) (string, bool) {
+	ctx := req.Context()
+
+	key, ok := ctx.Value(idempotencyKeyContextKey).(*idempotencyKey)
+	if !ok || key.key == "" {
+		return "", false
+	}
+
+	if req.Method == http.MethodGet || req.Method == http.MethodHead {
+		return "", false
+	}
+	if info, ok := CallInfoFromContext(ctx); ok && info.TokenExchange {
+		return "", false
+	}
+
+	if !key.used.CompareAndSwap(false, true) {
+		return "", false
+	}
+	return key.key, true
+}
+
+// WithIdempotent returns a context that marks requests as safe to retry regardless of HTTP method
+func WithIdempotent(ctx context.Context) context.Context {
+	return context.WithValue(ctx, idempotentContextKey, true)
+}
+
+// isIdempotent reports whether requests made with the context are marked as safe to retry
+func isIdempotent(ctx context.Context) bool {
+	idempotent, _ := ctx.Value(idempotentContextKey).(bool)
+	return idempotent
+}
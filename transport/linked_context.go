@@ -0,0 +1,17 @@
+package transport
+
+import "context"
+
+// WithLinkedContext returns ctx carrying from, the context of the request that started background work
+// (e.g. a shared token refresh) made with ctx. The background work must not inherit the values of from,
+// so only observability middlewares read it, e.g. tracing links spans to the span active in from
+// instead of parenting them.
+func WithLinkedContext(ctx, from context.Context) context.Context {
+	return context.WithValue(ctx, linkedContextKey, from)
+}
+
+// LinkedContextFromContext returns the context of the request that started background work made with ctx
+func LinkedContextFromContext(ctx context.Context) (context.Context, bool) {
+	from, ok := ctx.Value(linkedContextKey).(context.Context)
+	return from, ok
+}
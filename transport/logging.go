@@ -0,0 +1,149 @@
+package transport
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// maxLoggedBodySize caps the size of logged request and response bodies
+const maxLoggedBodySize = 4096
+
+// LogOptions configures request logging
+type LogOptions struct {
+	// RequestLevel is the level of outgoing request logs
+	RequestLevel slog.Level
+	// ResponseLevel is the level of successful response logs
+	ResponseLevel slog.Level
+	// ErrorLevel is the level of failed requests (transport errors and HTTP status >= 400)
+	ErrorLevel slog.Level
+	// LogHeaders adds request and response headers to logs
+	LogHeaders bool
+	// LogBodies adds request and response bodies to logs
+	LogBodies bool
+}
+
+// DefaultLogOptions returns the default logging options: requests and responses at debug level,
+// failures at warn level, no headers or bodies
+func DefaultLogOptions() LogOptions {
+	return LogOptions{
+		RequestLevel:  slog.LevelDebug,
+		ResponseLevel: slog.LevelDebug,
+		ErrorLevel:    slog.LevelWarn,
+	}
+}
+
+// LoggingTransport is an http.RoundTripper that logs requests and responses with log/slog.
+// IAM tokens, OAuth tokens, API key secrets and private keys are redacted from headers and bodies.
+type LoggingTransport struct {
+	base    http.RoundTripper
+	logger  *slog.Logger
+	options LogOptions
+}
+
+// NewLoggingTransport creates a new logging transport
+func NewLoggingTransport(base http.RoundTripper, logger *slog.Logger, options LogOptions) *LoggingTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &LoggingTransport{
+		base:    base,
+		logger:  logger,
+		options: options,
+	}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *LoggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	attrs := t.requestAttrs(req)
+	if t.logger.Enabled(ctx, t.options.RequestLevel) {
+		requestAttrs := attrs
+		if t.options.LogHeaders {
+			requestAttrs = append(requestAttrs, headerAttr("headers", req.Header))
+		}
+		if t.options.LogBodies {
+			if body, err := ReadRequestBody(req); err == nil && len(body) > 0 {
+				requestAttrs = append(requestAttrs, bodyAttr(body))
+			}
+		}
+		t.logger.LogAttrs(ctx, t.options.RequestLevel, "yandexcloud request", requestAttrs...)
+	}
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	attrs = append(attrs, slog.Duration("duration", time.Since(start)))
+
+	if err != nil {
+		attrs = append(attrs, slog.String("error", RedactString(err.Error())))
+		t.logger.LogAttrs(ctx, t.options.ErrorLevel, "yandexcloud request failed", attrs...)
+		return resp, err
+	}
+
+	level := t.options.ResponseLevel
+	if resp.StatusCode >= 400 {
+		level = t.options.ErrorLevel
+	}
+	if !t.logger.Enabled(ctx, level) {
+		return resp, nil
+	}
+
+	attrs = append(attrs, slog.Int("status", resp.StatusCode))
+	if requestID := resp.Header.Get(RequestIDHeader); requestID != "" {
+		attrs = append(attrs, slog.String("request_id", requestID))
+	}
+	if t.options.LogHeaders {
+		attrs = append(attrs, headerAttr("response_headers", resp.Header))
+	}
+	// Error bodies carry the error message, so they are logged even without LogBodies
+	if t.options.LogBodies || resp.StatusCode >= 400 {
+		if body, err := ReadResponseBody(resp); err == nil && len(body) > 0 {
+			attrs = append(attrs, bodyAttr(body))
+		}
+	}
+
+	t.logger.LogAttrs(ctx, level, "yandexcloud response", attrs...)
+
+	return resp, nil
+}
+
+// requestAttrs returns the attributes identifying a request
+func (t *LoggingTransport) requestAttrs(req *http.Request) []slog.Attr {
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("url", RedactString(req.URL.String())),
+	}
+	if info, ok := CallInfoFromContext(req.Context()); ok {
+		attrs = append(attrs, slog.String("call", info.Name()))
+	}
+	if clientRequestID := req.Header.Get(ClientRequestIDHeader); clientRequestID != "" {
+		attrs = append(attrs, slog.String("client_request_id", clientRequestID))
+	}
+	return attrs
+}
+
+// headerAttr returns a group of redacted headers
+func headerAttr(key string, header http.Header) slog.Attr {
+	attrs := make([]any, 0, len(header))
+	for name, values := range header {
+		redacted := make([]string, len(values))
+		for i, value := range values {
+			redacted[i] = RedactHeader(name, value)
+		}
+		attrs = append(attrs, slog.Any(name, redacted))
+	}
+	return slog.Group(key, attrs...)
+}
+
+// bodyAttr returns a redacted, truncated body
+func bodyAttr(body []byte) slog.Attr {
+	redacted := RedactBody(body)
+	if len(redacted) > maxLoggedBodySize {
+		redacted = append(redacted[:maxLoggedBodySize:maxLoggedBodySize], "..."...)
+	}
+	return slog.String("body", string(redacted))
+}
@@ -0,0 +1,74 @@
+package transport
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+)
+
+// Handler sends a request and returns its response
+type Handler func(req *http.Request) (*http.Response, error)
+
+// Middleware wraps a Handler, e.g. to inject headers, audit calls or stub responses in tests.
+// Middlewares must not modify the incoming request; use req.Clone to change it.
+type Middleware func(next Handler) Handler
+
+// MiddlewareTransport is an http.RoundTripper that passes every request through a middleware chain
+type MiddlewareTransport struct {
+	handler Handler
+}
+
+// NewMiddlewareTransport creates a new middleware transport.
+// The first middleware is the outermost one.
+func NewMiddlewareTransport(base http.RoundTripper, middlewares ...Middleware) *MiddlewareTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	handler := Handler(base.RoundTrip)
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		handler = middlewares[i](handler)
+	}
+
+	return &MiddlewareTransport{
+		handler: handler,
+	}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *MiddlewareTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.handler(req)
+}
+
+// ReadRequestBody returns the request body without consuming it
+func ReadRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+		return io.ReadAll(body)
+	}
+
+	data, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(data))
+	return data, err
+}
+
+// ReadResponseBody reads the response body and replaces it, so that it can still be read by the caller
+func ReadResponseBody(resp *http.Response) ([]byte, error) {
+	if resp.Body == nil {
+		return nil, nil
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	return data, err
+}
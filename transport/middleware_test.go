@@ -0,0 +1,153 @@
+package transport
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// roundTripperFunc adapts a function to http.RoundTripper
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// okResponse returns a 200 response with body
+func okResponse(req *http.Request, body string) *http.Response {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     make(http.Header),
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}
+}
+
+func TestMiddlewareTransportOrder(t *testing.T) {
+	var order []string
+	named := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return func(req *http.Request) (*http.Response, error) {
+				order = append(order, name+" before")
+				resp, err := next(req)
+				order = append(order, name+" after")
+				return resp, err
+			}
+		}
+	}
+	base := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		order = append(order, "base")
+		return okResponse(req, `{}`), nil
+	})
+
+	rt := NewMiddlewareTransport(base, named("first"), named("second"))
+	doRequest(t, rt, context.Background(), http.MethodGet, "https://example.com/")
+
+	want := []string{"first before", "second before", "base", "second after", "first after"}
+	if strings.Join(order, ", ") != strings.Join(want, ", ") {
+		t.Fatalf("order = %v, want %v", order, want)
+	}
+}
+
+func TestMiddlewareTransportShortCircuit(t *testing.T) {
+	base := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		t.Error("base transport called")
+		return nil, nil
+	})
+	stub := func(next Handler) Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			return okResponse(req, `{"id":"stubbed"}`), nil
+		}
+	}
+
+	rt := NewMiddlewareTransport(base, stub)
+	req, err := http.NewRequest(http.MethodGet, "https://example.com/", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if body, _ := io.ReadAll(resp.Body); string(body) != `{"id":"stubbed"}` {
+		t.Fatalf("body = %s", body)
+	}
+}
+
+func TestReadBodiesKeepThemReadable(t *testing.T) {
+	var seenRequest, seenResponse []byte
+	audit := func(next Handler) Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			body, err := ReadRequestBody(req)
+			if err != nil {
+				return nil, err
+			}
+			seenRequest = body
+
+			resp, err := next(req)
+			if err != nil {
+				return nil, err
+			}
+			seenResponse, err = ReadResponseBody(resp)
+			return resp, err
+		}
+	}
+
+	var baseRequest []byte
+	base := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		baseRequest, _ = io.ReadAll(req.Body)
+		return okResponse(req, `{"id":"b1g"}`), nil
+	})
+
+	rt := NewMiddlewareTransport(base, audit)
+
+	// A body without GetBody is replaced after reading
+	req, err := http.NewRequest(http.MethodPost, "https://example.com/", io.NopCloser(strings.NewReader(`{"name":"folder"}`)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req.GetBody != nil {
+		t.Fatal("request unexpectedly has GetBody")
+	}
+
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	callerResponse, _ := io.ReadAll(resp.Body)
+
+	if string(seenRequest) != `{"name":"folder"}` || string(baseRequest) != `{"name":"folder"}` {
+		t.Errorf("middleware saw %q, base got %q", seenRequest, baseRequest)
+	}
+	if string(seenResponse) != `{"id":"b1g"}` || string(callerResponse) != `{"id":"b1g"}` {
+		t.Errorf("middleware saw %q, caller got %q", seenResponse, callerResponse)
+	}
+}
+
+func TestReadRequestBodyUsesGetBody(t *testing.T) {
+	req, err := http.NewRequest(http.MethodPost, "https://example.com/", strings.NewReader(`{"name":"folder"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	original := req.Body
+
+	for i := 0; i < 2; i++ {
+		body, err := ReadRequestBody(req)
+		if err != nil || string(body) != `{"name":"folder"}` {
+			t.Fatalf("read %d = %q, %v", i+1, body, err)
+		}
+	}
+	if req.Body != original {
+		t.Error("ReadRequestBody replaced a body that has GetBody")
+	}
+
+	if body, err := ReadRequestBody(&http.Request{Body: http.NoBody}); body != nil || err != nil {
+		t.Errorf("empty body = %q, %v", body, err)
+	}
+}
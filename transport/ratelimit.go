@@ -0,0 +1,136 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimit configures a token bucket limiter
+type RateLimit struct {
+	// RequestsPerSecond is the sustained request rate
+	RequestsPerSecond float64
+	// Burst is the maximum number of requests sent at once (at least 1)
+	Burst int
+}
+
+// RateLimitTransport is an http.RoundTripper that limits request rate per service host.
+// Requests to hosts without a configured limit are not limited.
+type RateLimitTransport struct {
+	base     http.RoundTripper
+	limiters map[string]*tokenBucket
+}
+
+// NewRateLimitTransport creates a new rate limit transport.
+// Limits are keyed by service base URI (e.g. "https://iam.api.cloud.yandex.net/") or host.
+func NewRateLimitTransport(base http.RoundTripper, limits map[string]RateLimit) *RateLimitTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	limiters := make(map[string]*tokenBucket, len(limits))
+	for baseURI, limit := range limits {
+		limiters[hostOf(baseURI)] = newTokenBucket(limit)
+	}
+
+	return &RateLimitTransport{
+		base:     base,
+		limiters: limiters,
+	}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if limiter, ok := t.limiters[req.URL.Host]; ok {
+		if err := limiter.wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+	return t.base.RoundTrip(req)
+}
+
+// hostOf extracts host from base URI
+func hostOf(baseURI string) string {
+	if !strings.Contains(baseURI, "://") {
+		return strings.TrimSuffix(baseURI, "/")
+	}
+	u, err := url.Parse(baseURI)
+	if err != nil {
+		return baseURI
+	}
+	return u.Host
+}
+
+// tokenBucket is a goroutine-safe token bucket limiter
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	mu     sync.Mutex
+}
+
+// newTokenBucket creates a full token bucket
+func newTokenBucket(limit RateLimit) *tokenBucket {
+	burst := float64(limit.Burst)
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{
+		rate:   limit.RequestsPerSecond,
+		burst:  burst,
+		tokens: burst,
+		last:   time.Now(),
+	}
+}
+
+// wait takes a token, blocking until one is available or the context is done
+func (b *tokenBucket) wait(ctx context.Context) error {
+	if b.rate <= 0 {
+		return nil
+	}
+
+	delay := b.reserve()
+	if delay <= 0 {
+		return nil
+	}
+
+	if err := sleep(ctx, delay); err != nil {
+		b.cancel()
+		return err
+	}
+	return nil
+}
+
+// reserve takes a token (possibly borrowing from the future) and returns how long to wait for it
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.last = now
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// cancel returns a reserved token that was not used
+func (b *tokenBucket) cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens++
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+}
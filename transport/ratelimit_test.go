@@ -0,0 +1,122 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestTokenBucketBurstThenRate(t *testing.T) {
+	bucket := newTokenBucket(RateLimit{RequestsPerSecond: 50, Burst: 3})
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := bucket.wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Fatalf("burst took %v, want immediate", elapsed)
+	}
+
+	// Five more requests at 50 rps take about 100ms
+	for i := 0; i < 5; i++ {
+		if err := bucket.wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Fatalf("8 requests took %v, want at least 90ms", elapsed)
+	}
+}
+
+func TestTokenBucketMinimumBurst(t *testing.T) {
+	bucket := newTokenBucket(RateLimit{RequestsPerSecond: 1})
+
+	if delay := bucket.reserve(); delay != 0 {
+		t.Fatalf("first reserve delay = %v, want 0", delay)
+	}
+	if delay := bucket.reserve(); delay <= 0 {
+		t.Fatal("second reserve was not delayed")
+	}
+}
+
+func TestTokenBucketUnlimited(t *testing.T) {
+	bucket := newTokenBucket(RateLimit{})
+
+	for i := 0; i < 100; i++ {
+		if err := bucket.wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestTokenBucketCancelReturnsToken(t *testing.T) {
+	bucket := newTokenBucket(RateLimit{RequestsPerSecond: 10, Burst: 1})
+	bucket.reserve()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := bucket.wait(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("wait = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	// The cancelled reservation must not delay the next request further
+	bucket.mu.Lock()
+	tokens := bucket.tokens
+	bucket.mu.Unlock()
+	if tokens < -0.5 {
+		t.Fatalf("tokens = %v after cancelled wait, want about 0", tokens)
+	}
+}
+
+func TestRateLimitTransportLimitsConfiguredHosts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	limited := server.URL
+	serverURL, _ := url.Parse(server.URL)
+	// The same server under another host name is not limited
+	unlimited := "http://localhost:" + serverURL.Port()
+
+	rt := NewRateLimitTransport(nil, map[string]RateLimit{
+		limited + "/": {RequestsPerSecond: 20, Burst: 1},
+	})
+
+	start := time.Now()
+	for i := 0; i < 10; i++ {
+		doRequest(t, rt, context.Background(), http.MethodGet, unlimited).Body.Close()
+	}
+	if elapsed := time.Since(start); elapsed > 200*time.Millisecond {
+		t.Fatalf("unlimited requests took %v", elapsed)
+	}
+
+	start = time.Now()
+	for i := 0; i < 3; i++ {
+		doRequest(t, rt, context.Background(), http.MethodGet, limited).Body.Close()
+	}
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Fatalf("3 limited requests took %v, want at least 90ms", elapsed)
+	}
+}
+
+func TestHostOf(t *testing.T) {
+	tests := map[string]string{
+		"https://iam.api.cloud.yandex.net/":             "iam.api.cloud.yandex.net",
+		"https://iam.api.cloud.yandex.net/iam/v1/":      "iam.api.cloud.yandex.net",
+		"resource-manager.api.cloud.yandex.net":         "resource-manager.api.cloud.yandex.net",
+		"resource-manager.api.cloud.yandex.net/":        "resource-manager.api.cloud.yandex.net",
+		"http://127.0.0.1:8080":                         "127.0.0.1:8080",
+		"organization-manager.api.cloud.yandex.net:443": "organization-manager.api.cloud.yandex.net:443",
+	}
+
+	for baseURI, want := range tests {
+		if got := hostOf(baseURI); got != want {
+			t.Errorf("hostOf(%q) = %q, want %q", baseURI, got, want)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package transport
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Redacted replaces secret values in logs and recordings
+const Redacted = "[REDACTED]"
+
+// sensitiveFields lists JSON and form fields holding secrets (compared case-insensitively)
+var sensitiveFields = map[string]bool{
+	"iamtoken":                 true,
+	"yandexpassportoauthtoken": true,
+	"jwt":                      true,
+	"secret":                   true,
+	"privatekey":               true,
+	"private_key":              true,
+	"access_token":             true,
+	"subject_token":            true,
+	"refresh_token":            true,
+	"token":                    true,
+}
+
+// sensitiveHeaders lists headers holding secrets (canonical form)
+var sensitiveHeaders = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+	"Set-Cookie":          true,
+}
+
+// secretPattern masks secrets matching a regular expression in free text
+type secretPattern struct {
+	re          *regexp.Regexp
+	replacement string
+}
+
+// secretPatterns match IAM tokens, OAuth tokens, bearer credentials and PEM private keys
+var secretPatterns = []secretPattern{
+	{regexp.MustCompile(`t1\.[A-Za-z0-9_\-]+\.[A-Za-z0-9_\-]+`), Redacted},
+	{regexp.MustCompile(`\by[0-3]_[A-Za-z0-9_\-]{20,}`), Redacted},
+	{regexp.MustCompile(`(?i)\b(bearer|api-key)\s+[A-Za-z0-9._~+/=\-]+`), "${1} " + Redacted},
+	{regexp.MustCompile(`(?s)-----BEGIN [A-Z ]*PRIVATE KEY-----.*?-----END [A-Z ]*PRIVATE KEY-----`), Redacted},
+}
+
+// IsSensitiveHeader checks if the header holds secrets
+func IsSensitiveHeader(name string) bool {
+	return sensitiveHeaders[http.CanonicalHeaderKey(name)]
+}
+
+// RedactHeader returns the header value with secrets replaced by Redacted
+func RedactHeader(name, value string) string {
+	if !IsSensitiveHeader(name) {
+		return RedactString(value)
+	}
+
+	// Keep the scheme for readability, e.g. "Bearer [REDACTED]"
+	if scheme, _, ok := strings.Cut(value, " "); ok {
+		return scheme + " " + Redacted
+	}
+	return Redacted
+}
+
+// RedactBody returns a JSON or form-encoded body with values of secret fields replaced by Redacted.
+// Secrets found in other content are masked by pattern.
+func RedactBody(body []byte) []byte {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) == 0 {
+		return body
+	}
+
+	if trimmed[0] == '{' || trimmed[0] == '[' {
+		var data interface{}
+		if err := json.Unmarshal(trimmed, &data); err == nil {
+			if redacted, err := json.Marshal(redactValue(data)); err == nil {
+				return []byte(RedactString(string(redacted)))
+			}
+		}
+	}
+
+	if values, err := url.ParseQuery(string(trimmed)); err == nil && looksLikeForm(trimmed) {
+		for key := range values {
+			if isSensitiveField(key) {
+				values.Set(key, Redacted)
+			}
+		}
+		return []byte(RedactString(values.Encode()))
+	}
+
+	return []byte(RedactString(string(body)))
+}
+
+// RedactString masks secrets in free text by pattern
+func RedactString(s string) string {
+	for _, pattern := range secretPatterns {
+		s = pattern.re.ReplaceAllString(s, pattern.replacement)
+	}
+	return s
+}
+
+// redactValue replaces values of secret fields in decoded JSON
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if isSensitiveField(key) {
+				if _, ok := item.(string); ok {
+					v[key] = Redacted
+					continue
+				}
+			}
+			v[key] = redactValue(item)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+	}
+	return value
+}
+
+// isSensitiveField checks if the JSON or form field holds secrets
+func isSensitiveField(name string) bool {
+	return sensitiveFields[strings.ToLower(name)]
+}
+
+// looksLikeForm checks if the body is form-encoded rather than plain text
+func looksLikeForm(body []byte) bool {
+	return bytes.IndexByte(body, '=') > 0 && bytes.IndexAny(body, " \n\t") < 0
+}
@@ -0,0 +1,127 @@
+package transport
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"net/http"
+)
+
+// Correlation headers used by Yandex Cloud API
+const (
+	ClientRequestIDHeader = "X-Client-Request-Id"
+	ClientTraceIDHeader   = "X-Client-Trace-Id"
+	RequestIDHeader       = "X-Request-Id"
+	ServerTraceIDHeader   = "X-Server-Trace-Id"
+)
+
+const (
+	clientRequestIDContextKey contextKey = iota + 100
+	clientTraceIDContextKey
+	responseMetadataContextKey
+)
+
+// ResponseMetadata holds correlation data of a completed request
+type ResponseMetadata struct {
+	// ClientRequestID is the ID sent in the X-Client-Request-Id header
+	ClientRequestID string
+	// ClientTraceID is the ID sent in the X-Client-Trace-Id header
+	ClientTraceID string
+	// RequestID is the server request ID returned in the X-Request-Id header
+	RequestID string
+	// ServerTraceID is the server trace ID returned in the X-Server-Trace-Id header
+	ServerTraceID string
+	// StatusCode is the HTTP status code of the response
+	StatusCode int
+}
+
+// WithClientRequestID returns a context that makes requests use id as the client request ID
+// instead of a generated one
+func WithClientRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, clientRequestIDContextKey, id)
+}
+
+// WithClientTraceID returns a context that makes requests use id as the client trace ID
+// instead of the client request ID
+func WithClientTraceID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, clientTraceIDContextKey, id)
+}
+
+// WithResponseMetadata returns a context that makes requests store their correlation data in md.
+// When several requests share the context, md holds data of the last one,
+// so the context must not be shared by concurrent requests.
+func WithResponseMetadata(ctx context.Context, md *ResponseMetadata) context.Context {
+	return context.WithValue(ctx, responseMetadataContextKey, md)
+}
+
+// RequestIDTransport is an http.RoundTripper that sets client request and trace IDs,
+// attaches the idempotency key (see WithIdempotencyKey) and captures the server request ID
+type RequestIDTransport struct {
+	base http.RoundTripper
+}
+
+// NewRequestIDTransport creates a new request ID transport
+func NewRequestIDTransport(base http.RoundTripper) *RequestIDTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &RequestIDTransport{
+		base: base,
+	}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *RequestIDTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	requestID, _ := ctx.Value(clientRequestIDContextKey).(string)
+	if requestID == "" {
+		requestID = req.Header.Get(ClientRequestIDHeader)
+	}
+	if requestID == "" {
+		requestID = NewRequestID()
+	}
+
+	traceID, _ := ctx.Value(clientTraceIDContextKey).(string)
+	if traceID == "" {
+		traceID = req.Header.Get(ClientTraceIDHeader)
+	}
+	if traceID == "" {
+		traceID = requestID
+	}
+
+	req = req.Clone(ctx)
+	req.Header.Set(ClientRequestIDHeader, requestID)
+	req.Header.Set(ClientTraceIDHeader, traceID)
+	if key, ok := claimIdempotencyKey(req); ok && req.Header.Get(IdempotencyKeyHeader) == "" {
+		req.Header.Set(IdempotencyKeyHeader, key)
+	}
+
+	resp, err := t.base.RoundTrip(req)
+
+	if md, ok := ctx.Value(responseMetadataContextKey).(*ResponseMetadata); ok && md != nil {
+		md.ClientRequestID = requestID
+		md.ClientTraceID = traceID
+		md.RequestID = ""
+		md.ServerTraceID = ""
+		md.StatusCode = 0
+		if resp != nil {
+			md.RequestID = resp.Header.Get(RequestIDHeader)
+			md.ServerTraceID = resp.Header.Get(ServerTraceIDHeader)
+			md.StatusCode = resp.StatusCode
+		}
+	}
+
+	return resp, err
+}
+
+// NewRequestID generates a random (version 4) UUID
+func NewRequestID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return ""
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
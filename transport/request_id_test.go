@@ -0,0 +1,121 @@
+package transport
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/tigusigalpa/yandex-cloud-client-go/errors"
+)
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+// echoServer records correlation headers of the last request and responds with status and server IDs
+type echoServer struct {
+	*httptest.Server
+
+	requestID string
+	traceID   string
+}
+
+func newEchoServer(t *testing.T, status int, body string) *echoServer {
+	s := &echoServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.requestID = r.Header.Get(ClientRequestIDHeader)
+		s.traceID = r.Header.Get(ClientTraceIDHeader)
+
+		w.Header().Set(RequestIDHeader, "server-request-id")
+		w.Header().Set(ServerTraceIDHeader, "server-trace-id")
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestRequestIDTransportGeneratesIDs(t *testing.T) {
+	server := newEchoServer(t, http.StatusOK, `{}`)
+	rt := NewRequestIDTransport(nil)
+
+	doRequest(t, rt, context.Background(), http.MethodGet, server.URL)
+	first := server.requestID
+	doRequest(t, rt, context.Background(), http.MethodGet, server.URL)
+
+	if !uuidPattern.MatchString(first) {
+		t.Errorf("%s = %q, want a UUID", ClientRequestIDHeader, first)
+	}
+	if server.requestID == first {
+		t.Error("requests share a generated client request ID")
+	}
+	if server.traceID != server.requestID {
+		t.Errorf("%s = %q, want the client request ID %q", ClientTraceIDHeader, server.traceID, server.requestID)
+	}
+}
+
+func TestRequestIDTransportUsesContextIDs(t *testing.T) {
+	server := newEchoServer(t, http.StatusOK, `{}`)
+	rt := NewRequestIDTransport(nil)
+
+	ctx := WithClientRequestID(context.Background(), "my-request")
+	doRequest(t, rt, ctx, http.MethodGet, server.URL)
+	if server.requestID != "my-request" || server.traceID != "my-request" {
+		t.Errorf("request ID = %q, trace ID = %q; want my-request for both", server.requestID, server.traceID)
+	}
+
+	doRequest(t, rt, WithClientTraceID(ctx, "my-trace"), http.MethodGet, server.URL)
+	if server.requestID != "my-request" || server.traceID != "my-trace" {
+		t.Errorf("request ID = %q, trace ID = %q; want my-request, my-trace", server.requestID, server.traceID)
+	}
+}
+
+func TestRequestIDTransportCapturesResponseMetadata(t *testing.T) {
+	server := newEchoServer(t, http.StatusOK, `{}`)
+	rt := NewRequestIDTransport(nil)
+
+	var md ResponseMetadata
+	ctx := WithResponseMetadata(WithClientRequestID(context.Background(), "my-request"), &md)
+	doRequest(t, rt, ctx, http.MethodGet, server.URL)
+
+	want := ResponseMetadata{
+		ClientRequestID: "my-request",
+		ClientTraceID:   "my-request",
+		RequestID:       "server-request-id",
+		ServerTraceID:   "server-trace-id",
+		StatusCode:      http.StatusOK,
+	}
+	if md != want {
+		t.Fatalf("response metadata = %+v, want %+v", md, want)
+	}
+}
+
+func TestRequestIDTransportErrorResponse(t *testing.T) {
+	server := newEchoServer(t, http.StatusNotFound, `{"code":5,"message":"Folder not found"}`)
+	rt := NewRequestIDTransport(nil)
+
+	ctx := WithClientRequestID(context.Background(), "my-request")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	apiErr := errors.NewAPIErrorFromHTTPResponse(resp, body)
+	if apiErr.RequestID != "server-request-id" || apiErr.ClientRequestID != "my-request" {
+		t.Errorf("RequestID = %q, ClientRequestID = %q; want server-request-id, my-request", apiErr.RequestID, apiErr.ClientRequestID)
+	}
+	if apiErr.GRPCCode != errors.CodeNotFound {
+		t.Errorf("GRPCCode = %s, want %s", apiErr.GRPCCode, errors.CodeNotFound)
+	}
+}
@@ -0,0 +1,218 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy configures retries of transient API failures
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts including the first one (values below 2 disable retries)
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between retries
+	MaxBackoff time.Duration
+	// Multiplier increases the delay after each retry
+	Multiplier float64
+	// Jitter is the random fraction (0..1) subtracted from each delay
+	Jitter float64
+	// MaxRetryAfter caps the delay requested by a Retry-After header (MaxBackoff if zero)
+	MaxRetryAfter time.Duration
+	// RetryableStatusCodes lists HTTP status codes that are retried
+	RetryableStatusCodes []int
+}
+
+// DefaultRetryPolicy returns the default retry policy
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    4,
+		InitialBackoff: 200 * time.Millisecond,
+		MaxBackoff:     10 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// RetryTransport is an http.RoundTripper that retries transient failures according to a RetryPolicy.
+// Only idempotent methods are retried, unless the request carries an Idempotency-Key header
+// (see WithIdempotencyKey) or its context is marked with WithIdempotent. All attempts send the same key.
+type RetryTransport struct {
+	base   http.RoundTripper
+	policy RetryPolicy
+}
+
+// NewRetryTransport creates a new retry transport
+func NewRetryTransport(base http.RoundTripper, policy RetryPolicy) *RetryTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if policy.Multiplier < 1 {
+		policy.Multiplier = 1
+	}
+	return &RetryTransport{
+		base:   base,
+		policy: policy,
+	}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	retryable := t.policy.MaxAttempts > 1 && t.isRetryableRequest(req)
+	backoff := t.policy.InitialBackoff
+
+	for attempt := 1; ; attempt++ {
+		attemptReq := req
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(ctx)
+			attemptReq.Body = body
+		}
+
+		resp, err := t.base.RoundTrip(attemptReq)
+
+		if !retryable || attempt >= t.policy.MaxAttempts || !t.shouldRetry(ctx, resp, err) {
+			return resp, err
+		}
+
+		delay := t.jitter(backoff)
+		if resp != nil {
+			if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+				delay = t.capRetryAfter(retryAfter)
+			}
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		if err := sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+
+		backoff = time.Duration(float64(backoff) * t.policy.Multiplier)
+		if t.policy.MaxBackoff > 0 && backoff > t.policy.MaxBackoff {
+			backoff = t.policy.MaxBackoff
+		}
+	}
+}
+
+// isRetryableRequest checks if the request may be safely sent more than once
+func (t *RetryTransport) isRetryableRequest(req *http.Request) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return req.Header.Get(IdempotencyKeyHeader) != "" || isIdempotent(req.Context())
+}
+
+// shouldRetry checks if the attempt failed with a transient error
+func (t *RetryTransport) shouldRetry(ctx context.Context, resp *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		return isTransientError(err)
+	}
+
+	for _, code := range t.policy.RetryableStatusCodes {
+		if resp.StatusCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+// capRetryAfter limits the delay requested by the server to MaxRetryAfter (MaxBackoff if zero)
+func (t *RetryTransport) capRetryAfter(delay time.Duration) time.Duration {
+	limit := t.policy.MaxRetryAfter
+	if limit <= 0 {
+		limit = t.policy.MaxBackoff
+	}
+	if limit > 0 && delay > limit {
+		return limit
+	}
+	return delay
+}
+
+// jitter randomly shortens the delay by up to the policy jitter fraction
+func (t *RetryTransport) jitter(delay time.Duration) time.Duration {
+	if t.policy.Jitter <= 0 || delay <= 0 {
+		return delay
+	}
+	return delay - time.Duration(rand.Float64()*t.policy.Jitter*float64(delay))
+}
+
+// isTransientError checks if a transport error is worth retrying
+func isTransientError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	if errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// parseRetryAfter parses the Retry-After header (delay in seconds or HTTP date)
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
+
+// sleep waits for the delay or until the context is done
+func sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
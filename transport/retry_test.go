@@ -0,0 +1,182 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testRetryPolicy returns a fast retry policy for tests
+func testRetryPolicy() RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.InitialBackoff = time.Millisecond
+	policy.MaxBackoff = 5 * time.Millisecond
+	return policy
+}
+
+// flakyServer fails the first failures requests with status and records idempotency keys
+type flakyServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	failures int
+	status   int
+	header   http.Header
+	requests int
+	keys     []string
+}
+
+func newFlakyServer(t *testing.T, failures, status int) *flakyServer {
+	s := &flakyServer{failures: failures, status: status, header: make(http.Header)}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.requests++
+		s.keys = append(s.keys, r.Header.Get(IdempotencyKeyHeader))
+		if s.requests <= s.failures {
+			for name, values := range s.header {
+				w.Header()[name] = values
+			}
+			w.WriteHeader(s.status)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func doRequest(t *testing.T, rt http.RoundTripper, ctx context.Context, method, url string) *http.Response {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(ctx, method, url, strings.NewReader(`{"name":"folder"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	return resp
+}
+
+func TestRetryTransportRetriesIdempotentRequests(t *testing.T) {
+	server := newFlakyServer(t, 2, http.StatusServiceUnavailable)
+	rt := NewRetryTransport(nil, testRetryPolicy())
+
+	resp := doRequest(t, rt, context.Background(), http.MethodGet, server.URL)
+
+	if resp.StatusCode != http.StatusOK || server.requests != 3 {
+		t.Fatalf("status %d after %d requests, want 200 after 3", resp.StatusCode, server.requests)
+	}
+}
+
+func TestRetryTransportGivesUpAfterMaxAttempts(t *testing.T) {
+	server := newFlakyServer(t, 10, http.StatusServiceUnavailable)
+	policy := testRetryPolicy()
+	policy.MaxAttempts = 3
+	rt := NewRetryTransport(nil, policy)
+
+	resp := doRequest(t, rt, context.Background(), http.MethodGet, server.URL)
+
+	if resp.StatusCode != http.StatusServiceUnavailable || server.requests != 3 {
+		t.Fatalf("status %d after %d requests, want 503 after 3", resp.StatusCode, server.requests)
+	}
+}
+
+func TestRetryTransportDoesNotRetryPostWithoutKey(t *testing.T) {
+	server := newFlakyServer(t, 1, http.StatusServiceUnavailable)
+	rt := NewRetryTransport(nil, testRetryPolicy())
+
+	resp := doRequest(t, rt, context.Background(), http.MethodPost, server.URL)
+
+	if resp.StatusCode != http.StatusServiceUnavailable || server.requests != 1 {
+		t.Fatalf("status %d after %d requests, want 503 after 1", resp.StatusCode, server.requests)
+	}
+}
+
+func TestRetryTransportRetriesPostWithKey(t *testing.T) {
+	server := newFlakyServer(t, 1, http.StatusServiceUnavailable)
+	rt := NewRequestIDTransport(NewRetryTransport(nil, testRetryPolicy()))
+	ctx := WithIdempotencyKey(context.Background(), "key-1")
+
+	resp := doRequest(t, rt, ctx, http.MethodPost, server.URL)
+
+	if resp.StatusCode != http.StatusOK || server.requests != 2 {
+		t.Fatalf("status %d after %d requests, want 200 after 2", resp.StatusCode, server.requests)
+	}
+	for i, key := range server.keys {
+		if key != "key-1" {
+			t.Errorf("attempt %d: Idempotency-Key = %q, want key-1", i+1, key)
+		}
+	}
+}
+
+func TestIdempotencyKeyIsScopedToOneCall(t *testing.T) {
+	server := newFlakyServer(t, 0, 0)
+	rt := NewRequestIDTransport(NewRetryTransport(nil, testRetryPolicy()))
+	ctx := WithIdempotencyKey(context.Background(), "key-1")
+
+	tokenCtx := WithCallInfo(ctx, CallInfo{Service: "iam", Resource: "IAMTokens", Method: "Create", TokenExchange: true})
+	doRequest(t, rt, tokenCtx, http.MethodPost, server.URL)
+	doRequest(t, rt, ctx, http.MethodGet, server.URL)
+	doRequest(t, rt, ctx, http.MethodPost, server.URL)
+	doRequest(t, rt, ctx, http.MethodPost, server.URL)
+
+	want := []string{"", "", "key-1", ""}
+	for i, key := range server.keys {
+		if key != want[i] {
+			t.Errorf("request %d: Idempotency-Key = %q, want %q", i+1, key, want[i])
+		}
+	}
+}
+
+func TestRetryTransportCapsRetryAfter(t *testing.T) {
+	server := newFlakyServer(t, 1, http.StatusTooManyRequests)
+	server.header.Set("Retry-After", "3600")
+	rt := NewRetryTransport(nil, testRetryPolicy())
+
+	start := time.Now()
+	resp := doRequest(t, rt, context.Background(), http.MethodGet, server.URL)
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status %d, want 200", resp.StatusCode)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("retry took %s, want Retry-After capped at MaxBackoff", elapsed)
+	}
+}
+
+func TestRetryTransportStopsOnContextCancel(t *testing.T) {
+	server := newFlakyServer(t, 10, http.StatusServiceUnavailable)
+	policy := testRetryPolicy()
+	policy.InitialBackoff = time.Hour
+	policy.MaxBackoff = time.Hour
+	rt := NewRetryTransport(nil, policy)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	if _, err := rt.RoundTrip(req); err != context.DeadlineExceeded {
+		t.Fatalf("err = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	if delay, ok := parseRetryAfter("2"); !ok || delay != 2*time.Second {
+		t.Errorf("parseRetryAfter(2) = %s, %v", delay, ok)
+	}
+	if delay, ok := parseRetryAfter(time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat)); !ok || delay != 0 {
+		t.Errorf("parseRetryAfter(past date) = %s, %v", delay, ok)
+	}
+	if _, ok := parseRetryAfter("soon"); ok {
+		t.Error("parseRetryAfter(soon) ok = true")
+	}
+}
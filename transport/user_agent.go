@@ -0,0 +1,37 @@
+package transport
+
+import "net/http"
+
+// DefaultUserAgent is the User-Agent sent with every request
+const DefaultUserAgent = "yandex-cloud-client-go"
+
+// UserAgentTransport is an http.RoundTripper that sets the User-Agent header
+type UserAgentTransport struct {
+	base      http.RoundTripper
+	userAgent string
+}
+
+// NewUserAgentTransport creates a new user agent transport.
+// Non-empty suffix is appended to DefaultUserAgent.
+func NewUserAgentTransport(base http.RoundTripper, suffix string) *UserAgentTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	userAgent := DefaultUserAgent
+	if suffix != "" {
+		userAgent += " " + suffix
+	}
+
+	return &UserAgentTransport{
+		base:      base,
+		userAgent: userAgent,
+	}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *UserAgentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("User-Agent", t.userAgent)
+	return t.base.RoundTrip(req)
+}
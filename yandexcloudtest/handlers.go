@@ -0,0 +1,904 @@
+package yandexcloudtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tigusigalpa/yandex-cloud-client-go/errors"
+	"github.com/tigusigalpa/yandex-cloud-client-go/models"
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+	iamTokenTTL     = 12 * time.Hour
+	typePrefix      = "type.googleapis.com/"
+)
+
+// operation is an operation with its fake completion state
+type operation struct {
+	models.Operation
+	resourceID   string
+	pendingPolls int
+}
+
+// operationResult describes the operation returned by a mutating call
+type operationResult struct {
+	description  string
+	resourceID   string
+	metadataType string
+	metadata     map[string]string
+	responseType string
+	response     interface{}
+}
+
+// accessBindingDelta is a change of access bindings
+type accessBindingDelta struct {
+	Action        string               `json:"action"`
+	AccessBinding models.AccessBinding `json:"accessBinding"`
+}
+
+// serveHTTP routes requests to handlers
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, "/")
+
+	if path == "iam/v1/tokens" {
+		s.handleTokens(w, r)
+		return
+	}
+
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		writeError(w, errors.CodeUnauthenticated, "The token is invalid")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if rest, ok := cutCollection(path, "organization-manager/v1/organizations"); ok {
+		s.handleOrganizations(w, r, rest)
+		return
+	}
+	if rest, ok := cutCollection(path, "resource-manager/v1/clouds"); ok {
+		s.handleClouds(w, r, rest)
+		return
+	}
+	if rest, ok := cutCollection(path, "resource-manager/v1/folders"); ok {
+		s.handleFolders(w, r, rest)
+		return
+	}
+	if rest, ok := cutCollection(path, "iam/v1/serviceAccounts"); ok {
+		s.handleServiceAccounts(w, r, rest)
+		return
+	}
+	if rest, ok := cutCollection(path, "iam/v1/apiKeys"); ok {
+		s.handleAPIKeys(w, r, rest)
+		return
+	}
+	if rest, ok := cutCollection(path, "iam/v1/refreshTokens"); ok {
+		s.handleRefreshTokens(w, r, rest)
+		return
+	}
+	if rest, ok := cutCollection(path, "iam/v1/userAccounts"); ok && rest != "" && r.Method == http.MethodGet {
+		if userAccount, ok := getItem(w, s.userAccounts, rest, "User account"); ok {
+			writeJSON(w, userAccount)
+		}
+		return
+	}
+	if path == "iam/v1/yandexPassportUserAccounts:byLogin" && r.Method == http.MethodGet {
+		s.handleUserAccountByLogin(w, r)
+		return
+	}
+	if rest, ok := strings.CutPrefix(path, "operations/"); ok {
+		s.handleOperations(w, r, rest)
+		return
+	}
+
+	writeError(w, errors.CodeUnimplemented, fmt.Sprintf("Method %s /%s is not implemented", r.Method, path))
+}
+
+// handleTokens exchanges an OAuth token or a JWT for an IAM token
+func (s *Server) handleTokens(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, errors.CodeUnimplemented, "Method not allowed")
+		return
+	}
+
+	var body struct {
+		YandexPassportOauthToken string `json:"yandexPassportOauthToken"`
+		JWT                      string `json:"jwt"`
+	}
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	if body.YandexPassportOauthToken == "" && body.JWT == "" {
+		writeError(w, errors.CodeInvalidArgument, "OAuth token or JWT is required")
+		return
+	}
+
+	s.mu.Lock()
+	s.tokenRequests++
+	token := fmt.Sprintf("t1.yandexcloudtest.%d", s.tokenRequests)
+	ttl := s.TokenTTL
+	s.mu.Unlock()
+
+	if ttl <= 0 {
+		ttl = iamTokenTTL
+	}
+
+	writeJSON(w, map[string]string{
+		"iamToken":  token,
+		"expiresAt": time.Now().Add(ttl).UTC().Format(time.RFC3339Nano),
+	})
+}
+
+// handleOrganizations serves Organization Manager organizations
+func (s *Server) handleOrganizations(w http.ResponseWriter, r *http.Request, rest string) {
+	id, action, _ := strings.Cut(rest, ":")
+
+	switch {
+	case id == "" && r.Method == http.MethodGet:
+		writeList(w, r, "organizations", s.organizations.list(nil))
+
+	case id == "":
+		writeMethodNotAllowed(w, r)
+
+	case action != "":
+		if _, ok := getItem(w, s.organizations, id, "Organization"); ok {
+			s.handleAccessBindings(w, r, id, action)
+		}
+
+	case r.Method == http.MethodGet:
+		if organization, ok := getItem(w, s.organizations, id, "Organization"); ok {
+			writeJSON(w, organization)
+		}
+
+	case r.Method == http.MethodPatch:
+		organization, ok := getItem(w, s.organizations, id, "Organization")
+		if !ok || !patchItem(w, r, organization) {
+			return
+		}
+		s.writeOperation(w, operationResult{
+			description:  "Update organization",
+			resourceID:   id,
+			metadataType: "yandex.cloud.organizationmanager.v1.UpdateOrganizationMetadata",
+			metadata:     map[string]string{"organizationId": id},
+			responseType: "yandex.cloud.organizationmanager.v1.Organization",
+			response:     organization,
+		})
+
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+// handleClouds serves Resource Manager clouds
+func (s *Server) handleClouds(w http.ResponseWriter, r *http.Request, rest string) {
+	id, action, _ := strings.Cut(rest, ":")
+
+	switch {
+	case id == "" && r.Method == http.MethodGet:
+		organizationID := r.URL.Query().Get("organizationId")
+		writeList(w, r, "clouds", s.clouds.list(func(cloud *models.Cloud) bool {
+			return organizationID == "" || cloud.OrganizationID == organizationID
+		}))
+
+	case id == "" && r.Method == http.MethodPost:
+		var cloud models.Cloud
+		if !decodeBody(w, r, &cloud) || !requireName(w, cloud.Name) {
+			return
+		}
+		if _, ok := getItem(w, s.organizations, cloud.OrganizationID, "Organization"); !ok {
+			return
+		}
+		if len(s.clouds.list(func(c *models.Cloud) bool { return c.OrganizationID == cloud.OrganizationID && c.Name == cloud.Name })) > 0 {
+			writeError(w, errors.CodeAlreadyExists, fmt.Sprintf("Cloud with name %s already exists", cloud.Name))
+			return
+		}
+
+		cloud.ID = s.newID("b1g")
+		cloud.CreatedAt = time.Now().UTC()
+		s.clouds.add(cloud.ID, &cloud)
+
+		s.writeOperation(w, operationResult{
+			description:  "Create cloud",
+			resourceID:   cloud.ID,
+			metadataType: "yandex.cloud.resourcemanager.v1.CreateCloudMetadata",
+			metadata:     map[string]string{"cloudId": cloud.ID},
+			responseType: "yandex.cloud.resourcemanager.v1.Cloud",
+			response:     cloud,
+		})
+
+	case id == "":
+		writeMethodNotAllowed(w, r)
+
+	case action != "":
+		if _, ok := getItem(w, s.clouds, id, "Cloud"); ok {
+			s.handleAccessBindings(w, r, id, action)
+		}
+
+	case r.Method == http.MethodGet:
+		if cloud, ok := getItem(w, s.clouds, id, "Cloud"); ok {
+			writeJSON(w, cloud)
+		}
+
+	case r.Method == http.MethodPatch:
+		cloud, ok := getItem(w, s.clouds, id, "Cloud")
+		if !ok || !patchItem(w, r, cloud, "organizationId") {
+			return
+		}
+		s.writeOperation(w, operationResult{
+			description:  "Update cloud",
+			resourceID:   id,
+			metadataType: "yandex.cloud.resourcemanager.v1.UpdateCloudMetadata",
+			metadata:     map[string]string{"cloudId": id},
+			responseType: "yandex.cloud.resourcemanager.v1.Cloud",
+			response:     cloud,
+		})
+
+	case r.Method == http.MethodDelete:
+		if _, ok := getItem(w, s.clouds, id, "Cloud"); !ok {
+			return
+		}
+		s.clouds.remove(id)
+		delete(s.accessBindings, id)
+		s.writeOperation(w, operationResult{
+			description:  "Delete cloud",
+			resourceID:   id,
+			metadataType: "yandex.cloud.resourcemanager.v1.DeleteCloudMetadata",
+			metadata:     map[string]string{"cloudId": id},
+		})
+
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+// handleFolders serves Resource Manager folders
+func (s *Server) handleFolders(w http.ResponseWriter, r *http.Request, rest string) {
+	id, action, _ := strings.Cut(rest, ":")
+
+	if folderID, ok := strings.CutSuffix(id, "/operations"); ok && action == "" && r.Method == http.MethodGet {
+		if _, ok := getItem(w, s.folders, folderID, "Folder"); ok {
+			writeList(w, r, "operations", s.operations.list(func(op *operation) bool {
+				return op.resourceID == folderID
+			}), func(op operation) interface{} { return op.Operation })
+		}
+		return
+	}
+
+	switch {
+	case id == "" && r.Method == http.MethodGet:
+		cloudID := r.URL.Query().Get("cloudId")
+		if cloudID == "" {
+			writeError(w, errors.CodeInvalidArgument, "cloud_id is required")
+			return
+		}
+		writeList(w, r, "folders", s.folders.list(func(folder *models.Folder) bool {
+			return folder.CloudID == cloudID
+		}))
+
+	case id == "" && r.Method == http.MethodPost:
+		var folder models.Folder
+		if !decodeBody(w, r, &folder) || !requireName(w, folder.Name) {
+			return
+		}
+		if _, ok := getItem(w, s.clouds, folder.CloudID, "Cloud"); !ok {
+			return
+		}
+		if len(s.folders.list(func(f *models.Folder) bool { return f.CloudID == folder.CloudID && f.Name == folder.Name })) > 0 {
+			writeError(w, errors.CodeAlreadyExists, fmt.Sprintf("Folder with name %s already exists", folder.Name))
+			return
+		}
+
+		folder.ID = s.newID("b1g")
+		folder.CreatedAt = time.Now().UTC()
+		folder.Status = models.FolderStatusActive
+		s.folders.add(folder.ID, &folder)
+
+		s.writeOperation(w, operationResult{
+			description:  "Create folder",
+			resourceID:   folder.ID,
+			metadataType: "yandex.cloud.resourcemanager.v1.CreateFolderMetadata",
+			metadata:     map[string]string{"folderId": folder.ID},
+			responseType: "yandex.cloud.resourcemanager.v1.Folder",
+			response:     folder,
+		})
+
+	case id == "":
+		writeMethodNotAllowed(w, r)
+
+	case action != "":
+		if _, ok := getItem(w, s.folders, id, "Folder"); ok {
+			s.handleAccessBindings(w, r, id, action)
+		}
+
+	case r.Method == http.MethodGet:
+		if folder, ok := getItem(w, s.folders, id, "Folder"); ok {
+			writeJSON(w, folder)
+		}
+
+	case r.Method == http.MethodPatch:
+		folder, ok := getItem(w, s.folders, id, "Folder")
+		if !ok || !patchItem(w, r, folder, "cloudId", "status") {
+			return
+		}
+		s.writeOperation(w, operationResult{
+			description:  "Update folder",
+			resourceID:   id,
+			metadataType: "yandex.cloud.resourcemanager.v1.UpdateFolderMetadata",
+			metadata:     map[string]string{"folderId": id},
+			responseType: "yandex.cloud.resourcemanager.v1.Folder",
+			response:     folder,
+		})
+
+	case r.Method == http.MethodDelete:
+		if _, ok := getItem(w, s.folders, id, "Folder"); !ok {
+			return
+		}
+		s.folders.remove(id)
+		delete(s.accessBindings, id)
+		s.writeOperation(w, operationResult{
+			description:  "Delete folder",
+			resourceID:   id,
+			metadataType: "yandex.cloud.resourcemanager.v1.DeleteFolderMetadata",
+			metadata:     map[string]string{"folderId": id},
+		})
+
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+// handleServiceAccounts serves IAM service accounts
+func (s *Server) handleServiceAccounts(w http.ResponseWriter, r *http.Request, rest string) {
+	id, action, _ := strings.Cut(rest, ":")
+
+	switch {
+	case id == "" && r.Method == http.MethodGet:
+		folderID := r.URL.Query().Get("folderId")
+		if folderID == "" {
+			writeError(w, errors.CodeInvalidArgument, "folder_id is required")
+			return
+		}
+		writeList(w, r, "serviceAccounts", s.serviceAccounts.list(func(serviceAccount *models.ServiceAccount) bool {
+			return serviceAccount.FolderID == folderID
+		}))
+
+	case id == "" && r.Method == http.MethodPost:
+		var serviceAccount models.ServiceAccount
+		if !decodeBody(w, r, &serviceAccount) || !requireName(w, serviceAccount.Name) {
+			return
+		}
+		if _, ok := getItem(w, s.folders, serviceAccount.FolderID, "Folder"); !ok {
+			return
+		}
+		if len(s.serviceAccounts.list(func(sa *models.ServiceAccount) bool {
+			return sa.FolderID == serviceAccount.FolderID && sa.Name == serviceAccount.Name
+		})) > 0 {
+			writeError(w, errors.CodeAlreadyExists, fmt.Sprintf("Service account with name %s already exists", serviceAccount.Name))
+			return
+		}
+
+		serviceAccount.ID = s.newID("aje")
+		serviceAccount.CreatedAt = time.Now().UTC()
+		serviceAccount.LastAuthenticatedAt = nil
+		s.serviceAccounts.add(serviceAccount.ID, &serviceAccount)
+
+		s.writeOperation(w, operationResult{
+			description:  "Create service account",
+			resourceID:   serviceAccount.ID,
+			metadataType: "yandex.cloud.iam.v1.CreateServiceAccountMetadata",
+			metadata:     map[string]string{"serviceAccountId": serviceAccount.ID},
+			responseType: "yandex.cloud.iam.v1.ServiceAccount",
+			response:     serviceAccount,
+		})
+
+	case id == "":
+		writeMethodNotAllowed(w, r)
+
+	case action != "":
+		if _, ok := getItem(w, s.serviceAccounts, id, "Service account"); ok {
+			s.handleAccessBindings(w, r, id, action)
+		}
+
+	case r.Method == http.MethodGet:
+		if serviceAccount, ok := getItem(w, s.serviceAccounts, id, "Service account"); ok {
+			writeJSON(w, serviceAccount)
+		}
+
+	case r.Method == http.MethodPatch:
+		serviceAccount, ok := getItem(w, s.serviceAccounts, id, "Service account")
+		if !ok || !patchItem(w, r, serviceAccount, "folderId", "lastAuthenticatedAt") {
+			return
+		}
+		s.writeOperation(w, operationResult{
+			description:  "Update service account",
+			resourceID:   id,
+			metadataType: "yandex.cloud.iam.v1.UpdateServiceAccountMetadata",
+			metadata:     map[string]string{"serviceAccountId": id},
+			responseType: "yandex.cloud.iam.v1.ServiceAccount",
+			response:     serviceAccount,
+		})
+
+	case r.Method == http.MethodDelete:
+		if _, ok := getItem(w, s.serviceAccounts, id, "Service account"); !ok {
+			return
+		}
+		s.serviceAccounts.remove(id)
+		delete(s.accessBindings, id)
+		for _, apiKey := range s.apiKeys.list(func(key *models.APIKey) bool { return key.ServiceAccountID == id }) {
+			s.apiKeys.remove(apiKey.ID)
+		}
+		s.writeOperation(w, operationResult{
+			description:  "Delete service account",
+			resourceID:   id,
+			metadataType: "yandex.cloud.iam.v1.DeleteServiceAccountMetadata",
+			metadata:     map[string]string{"serviceAccountId": id},
+		})
+
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+// handleAPIKeys serves IAM API keys
+func (s *Server) handleAPIKeys(w http.ResponseWriter, r *http.Request, rest string) {
+	id := rest
+
+	switch {
+	case id == "" && r.Method == http.MethodGet:
+		serviceAccountID := r.URL.Query().Get("serviceAccountId")
+		writeList(w, r, "apiKeys", s.apiKeys.list(func(apiKey *models.APIKey) bool {
+			return serviceAccountID == "" || apiKey.ServiceAccountID == serviceAccountID
+		}))
+
+	case id == "" && r.Method == http.MethodPost:
+		var apiKey models.APIKey
+		if !decodeBody(w, r, &apiKey) {
+			return
+		}
+		if _, ok := getItem(w, s.serviceAccounts, apiKey.ServiceAccountID, "Service account"); !ok {
+			return
+		}
+
+		apiKey.ID = s.newID("aje")
+		apiKey.CreatedAt = time.Now().UTC()
+		apiKey.LastUsedAt = nil
+		s.apiKeys.add(apiKey.ID, &apiKey)
+
+		writeJSON(w, models.CreateAPIKeyResponse{
+			APIKey: apiKey,
+			Secret: fmt.Sprintf("AQVN-yandexcloudtest-%s", apiKey.ID),
+		})
+
+	case id == "":
+		writeMethodNotAllowed(w, r)
+
+	case r.Method == http.MethodGet:
+		if apiKey, ok := getItem(w, s.apiKeys, id, "API key"); ok {
+			writeJSON(w, apiKey)
+		}
+
+	case r.Method == http.MethodPatch:
+		apiKey, ok := getItem(w, s.apiKeys, id, "API key")
+		if !ok || !patchItem(w, r, apiKey, "serviceAccountId", "lastUsedAt") {
+			return
+		}
+		s.writeOperation(w, operationResult{
+			description:  "Update API key",
+			resourceID:   id,
+			metadataType: "yandex.cloud.iam.v1.UpdateApiKeyMetadata",
+			metadata:     map[string]string{"apiKeyId": id},
+			responseType: "yandex.cloud.iam.v1.ApiKey",
+			response:     apiKey,
+		})
+
+	case r.Method == http.MethodDelete:
+		if _, ok := getItem(w, s.apiKeys, id, "API key"); !ok {
+			return
+		}
+		s.apiKeys.remove(id)
+		s.writeOperation(w, operationResult{
+			description:  "Delete API key",
+			resourceID:   id,
+			metadataType: "yandex.cloud.iam.v1.DeleteApiKeyMetadata",
+			metadata:     map[string]string{"apiKeyId": id},
+		})
+
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+// handleRefreshTokens serves IAM refresh tokens
+func (s *Server) handleRefreshTokens(w http.ResponseWriter, r *http.Request, rest string) {
+	id := rest
+
+	switch {
+	case id == "" && r.Method == http.MethodGet:
+		writeList(w, r, "refreshTokens", s.refreshTokens.list(nil))
+
+	case id != "" && r.Method == http.MethodDelete:
+		if _, ok := getItem(w, s.refreshTokens, id, "Refresh token"); !ok {
+			return
+		}
+		s.refreshTokens.remove(id)
+		s.writeOperation(w, operationResult{
+			description:  "Revoke refresh token",
+			resourceID:   id,
+			metadataType: "yandex.cloud.iam.v1.RevokeRefreshTokenMetadata",
+			metadata:     map[string]string{"refreshTokenId": id},
+		})
+
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+// handleUserAccountByLogin finds a user account by Yandex Passport login
+func (s *Server) handleUserAccountByLogin(w http.ResponseWriter, r *http.Request) {
+	login := r.URL.Query().Get("login")
+	if login == "" {
+		writeError(w, errors.CodeInvalidArgument, "login is required")
+		return
+	}
+
+	userAccounts := s.userAccounts.list(func(userAccount *models.UserAccount) bool {
+		return userAccount.YandexPassportUserAccount != nil && userAccount.YandexPassportUserAccount.Login == login
+	})
+	if len(userAccounts) == 0 {
+		writeError(w, errors.CodeNotFound, fmt.Sprintf("User account with login %s not found", login))
+		return
+	}
+
+	writeJSON(w, userAccounts[0])
+}
+
+// handleAccessBindings serves :listAccessBindings, :setAccessBindings and :updateAccessBindings of a resource
+func (s *Server) handleAccessBindings(w http.ResponseWriter, r *http.Request, resourceID, action string) {
+	switch {
+	case action == "listAccessBindings" && r.Method == http.MethodGet:
+		writeList(w, r, "accessBindings", s.accessBindings[resourceID])
+
+	case action == "setAccessBindings" && r.Method == http.MethodPost:
+		var body struct {
+			AccessBindings []models.AccessBinding `json:"accessBindings"`
+		}
+		if !decodeBody(w, r, &body) {
+			return
+		}
+		for _, accessBinding := range body.AccessBindings {
+			if !validAccessBinding(w, accessBinding) {
+				return
+			}
+		}
+
+		s.accessBindings[resourceID] = body.AccessBindings
+		s.writeOperation(w, operationResult{
+			description:  "Set access bindings",
+			resourceID:   resourceID,
+			metadataType: "yandex.cloud.access.SetAccessBindingsMetadata",
+			metadata:     map[string]string{"resourceId": resourceID},
+		})
+
+	case action == "updateAccessBindings" && r.Method == http.MethodPost:
+		var body struct {
+			AccessBindingDeltas []accessBindingDelta `json:"accessBindingDeltas"`
+		}
+		if !decodeBody(w, r, &body) {
+			return
+		}
+
+		accessBindings := append([]models.AccessBinding(nil), s.accessBindings[resourceID]...)
+		for _, delta := range body.AccessBindingDeltas {
+			if !validAccessBinding(w, delta.AccessBinding) {
+				return
+			}
+
+			index := -1
+			for i, accessBinding := range accessBindings {
+				if accessBinding == delta.AccessBinding {
+					index = i
+					break
+				}
+			}
+
+			switch delta.Action {
+			case "ADD":
+				if index < 0 {
+					accessBindings = append(accessBindings, delta.AccessBinding)
+				}
+			case "REMOVE":
+				if index >= 0 {
+					accessBindings = append(accessBindings[:index], accessBindings[index+1:]...)
+				}
+			default:
+				writeError(w, errors.CodeInvalidArgument, fmt.Sprintf("Unknown access binding action %q", delta.Action))
+				return
+			}
+		}
+
+		s.accessBindings[resourceID] = accessBindings
+		s.writeOperation(w, operationResult{
+			description:  "Update access bindings",
+			resourceID:   resourceID,
+			metadataType: "yandex.cloud.access.UpdateAccessBindingsMetadata",
+			metadata:     map[string]string{"resourceId": resourceID},
+		})
+
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+// handleOperations serves Operation service get and cancel
+func (s *Server) handleOperations(w http.ResponseWriter, r *http.Request, rest string) {
+	id, action, _ := strings.Cut(rest, ":")
+	if r.Method != http.MethodGet || (action != "" && action != "cancel") {
+		writeMethodNotAllowed(w, r)
+		return
+	}
+
+	op, ok := getItem(w, s.operations, id, "Operation")
+	if !ok {
+		return
+	}
+
+	if action == "cancel" && op.pendingPolls > 0 {
+		// Changes are applied immediately, so cancellation only marks the operation as cancelled
+		op.pendingPolls = 0
+		op.ModifiedAt = time.Now().UTC()
+		op.Response = nil
+		op.Error = &models.Status{
+			Code:    int(errors.CodeCanceled),
+			Message: "Operation cancelled",
+		}
+	}
+
+	writeJSON(w, op.poll())
+}
+
+// writeOperation registers a completed operation and writes it
+func (s *Server) writeOperation(w http.ResponseWriter, result operationResult) {
+	now := time.Now().UTC()
+
+	op := &operation{
+		Operation: models.Operation{
+			ID:          s.newID("c1o"),
+			Description: result.description,
+			CreatedAt:   now,
+			ModifiedAt:  now,
+			Done:        true,
+			Metadata:    typedJSON(result.metadataType, result.metadata),
+		},
+		resourceID:   result.resourceID,
+		pendingPolls: s.PendingPolls,
+	}
+
+	if result.responseType != "" {
+		op.Response = typedJSON(result.responseType, result.response)
+	} else {
+		op.Response = typedJSON("google.protobuf.Empty", nil)
+	}
+
+	s.operations.add(op.ID, op)
+
+	writeJSON(w, op.snapshot())
+}
+
+// poll returns the operation as seen by a poll, counting down pending polls
+func (op *operation) poll() models.Operation {
+	snapshot := op.snapshot()
+	if op.pendingPolls > 0 {
+		op.pendingPolls--
+	}
+	return snapshot
+}
+
+// snapshot returns the operation, without its result while it is pending
+func (op *operation) snapshot() models.Operation {
+	snapshot := op.Operation
+	if op.pendingPolls > 0 {
+		snapshot.Done = false
+		snapshot.Response = nil
+		snapshot.Error = nil
+	}
+	return snapshot
+}
+
+// typedJSON marshals v as a JSON object with the protobuf Any "@type" field
+func typedJSON(typeName string, v interface{}) json.RawMessage {
+	fields := make(map[string]interface{})
+	if v != nil {
+		data, _ := json.Marshal(v)
+		json.Unmarshal(data, &fields)
+	}
+	fields["@type"] = typePrefix + typeName
+
+	data, _ := json.Marshal(fields)
+	return data
+}
+
+// cutCollection returns the part of path after the collection prefix ("" for the collection itself)
+func cutCollection(path, prefix string) (string, bool) {
+	if path == prefix {
+		return "", true
+	}
+	return strings.CutPrefix(path, prefix+"/")
+}
+
+// getItem returns an item by ID or writes a NOT_FOUND error
+func getItem[T any](w http.ResponseWriter, c *collection[T], id, kind string) (*T, bool) {
+	item, ok := c.get(id)
+	if !ok {
+		writeError(w, errors.CodeNotFound, fmt.Sprintf("%s %s not found", kind, id))
+	}
+	return item, ok
+}
+
+// patchItem applies the fields of a PATCH request body to item, except updateMask, id, createdAt and immutable fields
+func patchItem[T any](w http.ResponseWriter, r *http.Request, item *T, immutable ...string) bool {
+	var patch map[string]json.RawMessage
+	if !decodeBody(w, r, &patch) {
+		return false
+	}
+
+	delete(patch, "updateMask")
+	delete(patch, "id")
+	delete(patch, "createdAt")
+	for _, field := range immutable {
+		delete(patch, field)
+	}
+
+	current, err := json.Marshal(item)
+	if err != nil {
+		writeError(w, errors.CodeInternal, err.Error())
+		return false
+	}
+
+	fields := make(map[string]json.RawMessage)
+	json.Unmarshal(current, &fields)
+	for field, value := range patch {
+		fields[field] = value
+	}
+
+	merged, _ := json.Marshal(fields)
+
+	var updated T
+	if err := json.Unmarshal(merged, &updated); err != nil {
+		writeError(w, errors.CodeInvalidArgument, err.Error())
+		return false
+	}
+
+	*item = updated
+	return true
+}
+
+// writeList writes a page of items with the next page token.
+// An optional convert function maps stored items to their API representation.
+func writeList[T any](w http.ResponseWriter, r *http.Request, field string, items []T, convert ...func(T) interface{}) {
+	pageSize := defaultPageSize
+	if value := r.URL.Query().Get("pageSize"); value != "" {
+		size, err := strconv.Atoi(value)
+		if err != nil || size < 0 || size > maxPageSize {
+			writeError(w, errors.CodeInvalidArgument, "Invalid page size")
+			return
+		}
+		if size > 0 {
+			pageSize = size
+		}
+	}
+
+	offset := 0
+	if value := r.URL.Query().Get("pageToken"); value != "" {
+		start, err := strconv.Atoi(value)
+		if err != nil || start < 0 || start > len(items) {
+			writeError(w, errors.CodeInvalidArgument, "Invalid page token")
+			return
+		}
+		offset = start
+	}
+
+	end := offset + pageSize
+	if end > len(items) {
+		end = len(items)
+	}
+
+	page := make([]interface{}, 0, end-offset)
+	for _, item := range items[offset:end] {
+		if len(convert) > 0 {
+			page = append(page, convert[0](item))
+		} else {
+			page = append(page, item)
+		}
+	}
+
+	response := map[string]interface{}{
+		field: page,
+	}
+	if end < len(items) {
+		response["nextPageToken"] = strconv.Itoa(end)
+	}
+
+	writeJSON(w, response)
+}
+
+// decodeBody decodes a JSON request body or writes an INVALID_ARGUMENT error
+func decodeBody(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, errors.CodeInvalidArgument, "Invalid request body: "+err.Error())
+		return false
+	}
+	return true
+}
+
+// requireName writes an INVALID_ARGUMENT error if name is empty
+func requireName(w http.ResponseWriter, name string) bool {
+	if name == "" {
+		writeError(w, errors.CodeInvalidArgument, "name is required")
+		return false
+	}
+	return true
+}
+
+// validAccessBinding writes an INVALID_ARGUMENT error if the access binding is incomplete
+func validAccessBinding(w http.ResponseWriter, accessBinding models.AccessBinding) bool {
+	if accessBinding.RoleID == "" || accessBinding.Subject.ID == "" || accessBinding.Subject.Type == "" {
+		writeError(w, errors.CodeInvalidArgument, "Access binding must have role ID, subject ID and subject type")
+		return false
+	}
+	return true
+}
+
+// writeMethodNotAllowed writes an UNIMPLEMENTED error
+func writeMethodNotAllowed(w http.ResponseWriter, r *http.Request) {
+	writeError(w, errors.CodeUnimplemented, fmt.Sprintf("Method %s %s is not implemented", r.Method, r.URL.Path))
+}
+
+// writeJSON writes a 200 OK JSON response
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an API error in the Yandex Cloud REST format
+func writeError(w http.ResponseWriter, code errors.GRPCCode, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus(code))
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"code":    int(code),
+		"message": message,
+		"details": []interface{}{},
+	})
+}
+
+// httpStatus maps a gRPC code to the HTTP status used by the Yandex Cloud REST API
+func httpStatus(code errors.GRPCCode) int {
+	switch code {
+	case errors.CodeInvalidArgument, errors.CodeFailedPrecondition, errors.CodeOutOfRange:
+		return http.StatusBadRequest
+	case errors.CodeUnauthenticated:
+		return http.StatusUnauthorized
+	case errors.CodePermissionDenied:
+		return http.StatusForbidden
+	case errors.CodeNotFound:
+		return http.StatusNotFound
+	case errors.CodeAlreadyExists, errors.CodeAborted:
+		return http.StatusConflict
+	case errors.CodeResourceExhausted:
+		return http.StatusTooManyRequests
+	case errors.CodeUnimplemented:
+		return http.StatusNotImplemented
+	case errors.CodeUnavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,285 @@
+// Package yandexcloudtest provides an in-process fake of the Yandex Cloud APIs used by the client
+package yandexcloudtest
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	yandexcloud "github.com/tigusigalpa/yandex-cloud-client-go"
+	"github.com/tigusigalpa/yandex-cloud-client-go/models"
+	"github.com/tigusigalpa/yandex-cloud-client-go/resources"
+)
+
+// OAuthToken is the OAuth token used by clients created by Server.Client
+const OAuthToken = "y0_yandexcloudtest"
+
+// PollInterval is the operation poll interval of clients created by Server.Client
+const PollInterval = 5 * time.Millisecond
+
+// Server is an httptest-based fake implementing IAM token exchange, Organization Manager,
+// Resource Manager, IAM and Operation endpoints with in-memory state.
+//
+// Mutating calls return operations that apply their changes immediately.
+// Set PendingPolls to make new operations report done only on the poll after that many polls.
+type Server struct {
+	// URL is the base URL of the server
+	URL string
+	// PendingPolls is the number of polls for which a new operation is reported as not done
+	PendingPolls int
+	// TokenTTL is the lifetime of issued IAM tokens (12 hours if zero)
+	TokenTTL time.Duration
+
+	server *httptest.Server
+
+	mu              sync.Mutex
+	seq             int
+	tokenRequests   int
+	organizations   *collection[models.Organization]
+	clouds          *collection[models.Cloud]
+	folders         *collection[models.Folder]
+	serviceAccounts *collection[models.ServiceAccount]
+	apiKeys         *collection[models.APIKey]
+	refreshTokens   *collection[models.RefreshToken]
+	userAccounts    *collection[models.UserAccount]
+	operations      *collection[operation]
+	accessBindings  map[string][]models.AccessBinding
+}
+
+// NewServer starts a new fake server (call Close when done)
+func NewServer() *Server {
+	s := &Server{
+		organizations:   newCollection[models.Organization](),
+		clouds:          newCollection[models.Cloud](),
+		folders:         newCollection[models.Folder](),
+		serviceAccounts: newCollection[models.ServiceAccount](),
+		apiKeys:         newCollection[models.APIKey](),
+		refreshTokens:   newCollection[models.RefreshToken](),
+		userAccounts:    newCollection[models.UserAccount](),
+		operations:      newCollection[operation](),
+		accessBindings:  make(map[string][]models.AccessBinding),
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.server.URL
+
+	return s
+}
+
+// NewClient starts a fake server and returns a client wired to it.
+// The server is closed when the test ends.
+func NewClient(t testing.TB, opts ...yandexcloud.Option) (*yandexcloud.Client, *Server) {
+	t.Helper()
+
+	s := NewServer()
+	t.Cleanup(s.Close)
+
+	client, err := s.Client(opts...)
+	if err != nil {
+		t.Fatalf("yandexcloudtest: failed to create client: %v", err)
+	}
+
+	return client, s
+}
+
+// Close shuts down the server
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Client returns a client authenticated with OAuthToken whose endpoints point to the server.
+// Operations are polled every PollInterval.
+func (s *Server) Client(opts ...yandexcloud.Option) (*yandexcloud.Client, error) {
+	baseURI := s.URL + "/"
+
+	opts = append([]yandexcloud.Option{
+		yandexcloud.WithOAuthToken(OAuthToken),
+		yandexcloud.WithHTTPClient(s.server.Client()),
+		yandexcloud.WithEndpoint(yandexcloud.ServiceIAM, baseURI),
+		yandexcloud.WithEndpoint(yandexcloud.ServiceResourceManager, baseURI),
+		yandexcloud.WithEndpoint(yandexcloud.ServiceOrganizationManager, baseURI),
+		yandexcloud.WithEndpoint(yandexcloud.ServiceOperation, baseURI),
+		yandexcloud.WithPollPolicy(resources.PollPolicy{
+			InitialInterval: PollInterval,
+			MaxInterval:     PollInterval,
+			Multiplier:      1,
+		}),
+	}, opts...)
+
+	return yandexcloud.NewClientWithOptions(opts...)
+}
+
+// AddOrganization adds an organization (ID and creation time are generated if empty)
+func (s *Server) AddOrganization(organization models.Organization) models.Organization {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	organization.ID = s.idOrNew(organization.ID, "bpf")
+	organization.CreatedAt = createdAtOrNow(organization.CreatedAt)
+	s.organizations.add(organization.ID, &organization)
+
+	return organization
+}
+
+// AddCloud adds a cloud (ID and creation time are generated if empty)
+func (s *Server) AddCloud(cloud models.Cloud) models.Cloud {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cloud.ID = s.idOrNew(cloud.ID, "b1g")
+	cloud.CreatedAt = createdAtOrNow(cloud.CreatedAt)
+	s.clouds.add(cloud.ID, &cloud)
+
+	return cloud
+}
+
+// AddFolder adds a folder (ID, creation time and status are generated if empty)
+func (s *Server) AddFolder(folder models.Folder) models.Folder {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	folder.ID = s.idOrNew(folder.ID, "b1g")
+	folder.CreatedAt = createdAtOrNow(folder.CreatedAt)
+	if folder.Status == "" {
+		folder.Status = models.FolderStatusActive
+	}
+	s.folders.add(folder.ID, &folder)
+
+	return folder
+}
+
+// AddServiceAccount adds a service account (ID and creation time are generated if empty)
+func (s *Server) AddServiceAccount(serviceAccount models.ServiceAccount) models.ServiceAccount {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	serviceAccount.ID = s.idOrNew(serviceAccount.ID, "aje")
+	serviceAccount.CreatedAt = createdAtOrNow(serviceAccount.CreatedAt)
+	s.serviceAccounts.add(serviceAccount.ID, &serviceAccount)
+
+	return serviceAccount
+}
+
+// AddUserAccount adds a user account (ID is generated if empty)
+func (s *Server) AddUserAccount(userAccount models.UserAccount) models.UserAccount {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	userAccount.ID = s.idOrNew(userAccount.ID, "aje")
+	s.userAccounts.add(userAccount.ID, &userAccount)
+
+	return userAccount
+}
+
+// AddRefreshToken adds a refresh token (ID and creation time are generated if empty)
+func (s *Server) AddRefreshToken(refreshToken models.RefreshToken) models.RefreshToken {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	refreshToken.ID = s.idOrNew(refreshToken.ID, "ajt")
+	refreshToken.CreatedAt = createdAtOrNow(refreshToken.CreatedAt)
+	s.refreshTokens.add(refreshToken.ID, &refreshToken)
+
+	return refreshToken
+}
+
+// AddAccessBinding adds an access binding to the resource with resourceID
+func (s *Server) AddAccessBinding(resourceID string, accessBinding models.AccessBinding) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.accessBindings[resourceID] = append(s.accessBindings[resourceID], accessBinding)
+}
+
+// AccessBindings returns access bindings of the resource with resourceID
+func (s *Server) AccessBindings(resourceID string) []models.AccessBinding {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]models.AccessBinding(nil), s.accessBindings[resourceID]...)
+}
+
+// TokenRequests returns the number of IAM token exchange requests received
+func (s *Server) TokenRequests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.tokenRequests
+}
+
+// newID generates a 20-character ID with prefix (s.mu must be held)
+func (s *Server) newID(prefix string) string {
+	s.seq++
+	return fmt.Sprintf("%s%0*d", prefix, 20-len(prefix), s.seq)
+}
+
+// idOrNew returns id, or a new ID if it is empty (s.mu must be held)
+func (s *Server) idOrNew(id, prefix string) string {
+	if id != "" {
+		return id
+	}
+	return s.newID(prefix)
+}
+
+// createdAtOrNow returns createdAt, or the current time if it is zero
+func createdAtOrNow(createdAt time.Time) time.Time {
+	if createdAt.IsZero() {
+		return time.Now().UTC()
+	}
+	return createdAt
+}
+
+// collection is an ordered in-memory set of items keyed by ID
+type collection[T any] struct {
+	items map[string]*T
+	ids   []string
+}
+
+// newCollection creates a new collection
+func newCollection[T any]() *collection[T] {
+	return &collection[T]{
+		items: make(map[string]*T),
+	}
+}
+
+// add adds or replaces an item
+func (c *collection[T]) add(id string, item *T) {
+	if _, ok := c.items[id]; !ok {
+		c.ids = append(c.ids, id)
+	}
+	c.items[id] = item
+}
+
+// get returns an item by ID
+func (c *collection[T]) get(id string) (*T, bool) {
+	item, ok := c.items[id]
+	return item, ok
+}
+
+// remove removes an item by ID
+func (c *collection[T]) remove(id string) {
+	if _, ok := c.items[id]; !ok {
+		return
+	}
+	delete(c.items, id)
+	for i, itemID := range c.ids {
+		if itemID == id {
+			c.ids = append(c.ids[:i], c.ids[i+1:]...)
+			break
+		}
+	}
+}
+
+// list returns items matching filter (all if nil) in insertion order
+func (c *collection[T]) list(filter func(*T) bool) []T {
+	items := make([]T, 0, len(c.ids))
+	for _, id := range c.ids {
+		item := c.items[id]
+		if filter == nil || filter(item) {
+			items = append(items, *item)
+		}
+	}
+	return items
+}
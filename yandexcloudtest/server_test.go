@@ -0,0 +1,222 @@
+package yandexcloudtest_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/tigusigalpa/yandex-cloud-client-go/errors"
+	"github.com/tigusigalpa/yandex-cloud-client-go/models"
+	"github.com/tigusigalpa/yandex-cloud-client-go/yandexcloudtest"
+)
+
+func TestFolderLifecycle(t *testing.T) {
+	client, server := yandexcloudtest.NewClient(t)
+	cloud := server.AddCloud(models.Cloud{Name: "cloud"})
+	ctx := context.Background()
+
+	description := "test folder"
+	op, err := client.Folders().Create(ctx, cloud.ID, "folder", &description, map[string]string{"env": "test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !op.Done {
+		t.Fatal("operation is not done without PendingPolls")
+	}
+
+	var folder models.Folder
+	if _, err := client.Operations().Wait(ctx, op.ID, &folder); err != nil {
+		t.Fatal(err)
+	}
+	if folder.ID == "" || folder.CloudID != cloud.ID || folder.Description != description || folder.Labels["env"] != "test" {
+		t.Fatalf("created folder = %+v", folder)
+	}
+
+	if _, err := client.Folders().Create(ctx, cloud.ID, "folder", nil, nil); errors.CodeOf(err) != errors.CodeAlreadyExists {
+		t.Fatalf("duplicate create: code = %s, want %s", errors.CodeOf(err), errors.CodeAlreadyExists)
+	}
+
+	if _, err := client.Folders().Update(ctx, folder.ID, map[string]interface{}{"name": "renamed"}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := client.Folders().Get(ctx, folder.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Name != "renamed" || got.Labels["env"] != "test" {
+		t.Fatalf("updated folder = %+v", got)
+	}
+
+	operations, err := client.Folders().ListAllOperations(ctx, folder.ID, nil).All()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(operations) != 2 {
+		t.Fatalf("folder has %d operations, want 2", len(operations))
+	}
+
+	if _, err := client.Folders().Delete(ctx, folder.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Folders().Get(ctx, folder.ID); errors.CodeOf(err) != errors.CodeNotFound {
+		t.Fatalf("get deleted folder: code = %s, want %s", errors.CodeOf(err), errors.CodeNotFound)
+	}
+}
+
+func TestPagination(t *testing.T) {
+	client, server := yandexcloudtest.NewClient(t)
+	cloud := server.AddCloud(models.Cloud{Name: "cloud"})
+	other := server.AddCloud(models.Cloud{Name: "other"})
+	for i := 0; i < 7; i++ {
+		server.AddFolder(models.Folder{CloudID: cloud.ID, Name: "folder"})
+	}
+	server.AddFolder(models.Folder{CloudID: other.ID, Name: "other"})
+	ctx := context.Background()
+
+	pageSize := 3
+	page, err := client.Folders().List(ctx, cloud.ID, &pageSize, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Folders) != 3 || page.NextPageToken == "" {
+		t.Fatalf("first page has %d folders, next page token %q", len(page.Folders), page.NextPageToken)
+	}
+
+	folders, err := client.Folders().ListAll(ctx, cloud.ID, &pageSize).All()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(folders) != 7 {
+		t.Fatalf("listed %d folders, want 7", len(folders))
+	}
+
+	seen := make(map[string]bool)
+	for _, folder := range folders {
+		if folder.CloudID != cloud.ID || seen[folder.ID] {
+			t.Fatalf("unexpected folder %+v", folder)
+		}
+		seen[folder.ID] = true
+	}
+}
+
+func TestAccessBindings(t *testing.T) {
+	client, server := yandexcloudtest.NewClient(t)
+	serviceAccount := server.AddServiceAccount(models.ServiceAccount{FolderID: "b1gfolder", Name: "robot"})
+	ctx := context.Background()
+
+	if _, err := client.ServiceAccounts().AddRole(ctx, serviceAccount.ID, "ajeuser", "editor", ""); err != nil {
+		t.Fatal(err)
+	}
+
+	want := models.AccessBinding{RoleID: "editor", Subject: models.Subject{ID: "ajeuser", Type: "userAccount"}}
+	if bindings := server.AccessBindings(serviceAccount.ID); len(bindings) != 1 || bindings[0] != want {
+		t.Fatalf("access bindings = %+v, want [%+v]", bindings, want)
+	}
+
+	bindings, err := client.ServiceAccounts().ListAllAccessBindings(ctx, serviceAccount.ID, nil).All()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bindings) != 1 || bindings[0] != want {
+		t.Fatalf("listed access bindings = %+v", bindings)
+	}
+
+	if _, err := client.ServiceAccounts().RemoveRole(ctx, serviceAccount.ID, "ajeuser", "editor", ""); err != nil {
+		t.Fatal(err)
+	}
+	if bindings := server.AccessBindings(serviceAccount.ID); len(bindings) != 0 {
+		t.Fatalf("access bindings after remove = %+v", bindings)
+	}
+}
+
+func TestPendingOperations(t *testing.T) {
+	client, server := yandexcloudtest.NewClient(t)
+	server.PendingPolls = 2
+	cloud := server.AddCloud(models.Cloud{Name: "cloud"})
+	ctx := context.Background()
+
+	op, err := client.Folders().Create(ctx, cloud.ID, "folder", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if op.Done {
+		t.Fatal("operation is done when created")
+	}
+	for i := 1; i <= 3; i++ {
+		if op, err = client.Operations().Get(ctx, op.ID); err != nil {
+			t.Fatal(err)
+		}
+		if op.Done != (i > server.PendingPolls) {
+			t.Fatalf("poll %d: done = %v", i, op.Done)
+		}
+	}
+
+	if _, err := client.Operations().Get(ctx, "unknown"); errors.CodeOf(err) != errors.CodeNotFound {
+		t.Fatalf("unknown operation: code = %s, want %s", errors.CodeOf(err), errors.CodeNotFound)
+	}
+}
+
+func TestAPIKeysAndUserAccounts(t *testing.T) {
+	client, server := yandexcloudtest.NewClient(t)
+	serviceAccount := server.AddServiceAccount(models.ServiceAccount{FolderID: "b1gfolder", Name: "robot"})
+	user := server.AddUserAccount(models.UserAccount{
+		YandexPassportUserAccount: &models.YandexPassportUserAccount{Login: "alice"},
+	})
+	ctx := context.Background()
+
+	created, err := client.APIKeys().Create(ctx, serviceAccount.ID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.Secret == "" || created.APIKey.ServiceAccountID != serviceAccount.ID {
+		t.Fatalf("created API key = %+v", created)
+	}
+
+	keys, err := client.APIKeys().ListAll(ctx, serviceAccount.ID, nil).All()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 1 || keys[0].ID != created.APIKey.ID {
+		t.Fatalf("listed API keys = %+v", keys)
+	}
+
+	account, err := client.YandexPassportUserAccounts().GetByLogin(ctx, "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if account.ID != user.ID {
+		t.Fatalf("user account ID = %q, want %q", account.ID, user.ID)
+	}
+}
+
+func TestTokenExchange(t *testing.T) {
+	client, server := yandexcloudtest.NewClient(t)
+	cloud := server.AddCloud(models.Cloud{Name: "cloud"})
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.Clouds().Get(ctx, cloud.ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if n := server.TokenRequests(); n != 1 {
+		t.Fatalf("token requests = %d, want 1 (the IAM token is cached)", n)
+	}
+}
+
+func TestUnauthenticated(t *testing.T) {
+	server := yandexcloudtest.NewServer()
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/resource-manager/v1/clouds")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("status = %d, want %d", resp.StatusCode, http.StatusUnauthorized)
+	}
+}